@@ -0,0 +1,319 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/pimvanhespen/advent-of-code/pkg/aoc"
+)
+
+type BenchConfig struct {
+	Selection
+	Count int
+	Out   string
+	Base  string
+}
+
+func benchCommand(args []string) error {
+	if len(args) > 0 && args[0] == "compare" {
+		return compareCommand(args[1:])
+	}
+
+	var c BenchConfig
+
+	fs := flag.NewFlagSet("bench", flag.ExitOnError)
+	c.Selection.Register(fs)
+	fs.IntVar(&c.Count, "count", 6, "run every benchmark `N` times")
+	fs.StringVar(&c.Out, "out", "", "store the results in this file (default benchmarks/<commit>.txt)")
+	fs.StringVar(&c.Base, "base", "", "compare the results to the results in this file")
+	_ = fs.Parse(args)
+
+	if !c.IsValid() {
+		fs.Usage()
+		os.Exit(2)
+	}
+
+	solutions, err := c.Solutions()
+	if err != nil {
+		return err
+	}
+
+	root, err := aoc.Root()
+	if err != nil {
+		return err
+	}
+
+	if c.Out == "" {
+		c.Out = filepath.Join(root, "benchmarks", revision(root)+".txt")
+	}
+
+	bench := "^Benchmark(Parse|Part1|Part2)$"
+	if c.Part != 0 {
+		bench = fmt.Sprintf("^Benchmark(Parse|Part%d)$", c.Part)
+	}
+
+	cmdArgs := []string{"test", "-run", "^$", "-bench", bench, "-benchmem", "-count", strconv.Itoa(c.Count)}
+	for _, s := range solutions {
+		cmdArgs = append(cmdArgs, fmt.Sprintf("./events/%04d/%02d", s.Challenge.Year, s.Challenge.Day))
+	}
+
+	var out bytes.Buffer
+
+	cmd := exec.Command("go", cmdArgs...)
+	cmd.Dir = root
+	cmd.Stdout = io.MultiWriter(os.Stdout, &out)
+	cmd.Stderr = os.Stderr
+
+	// failing packages are reported by go test, keep the results of the others
+	runErr := cmd.Run()
+
+	if err = os.MkdirAll(filepath.Dir(c.Out), 0755); err != nil {
+		return err
+	}
+	if err = os.WriteFile(c.Out, out.Bytes(), 0644); err != nil {
+		return err
+	}
+	_, _ = fmt.Fprintln(os.Stderr, "results stored in", c.Out)
+
+	if c.Base != "" {
+		if err = compareFiles(os.Stdout, c.Base, c.Out); err != nil {
+			return err
+		}
+	}
+
+	if runErr != nil {
+		return fmt.Errorf("go test: %w", runErr)
+	}
+
+	return nil
+}
+
+func compareCommand(args []string) error {
+	fs := flag.NewFlagSet("bench compare", flag.ExitOnError)
+	fs.Usage = func() {
+		_, _ = fmt.Fprintln(fs.Output(), "usage: aoc bench compare OLD NEW")
+	}
+	_ = fs.Parse(args)
+
+	if fs.NArg() != 2 {
+		fs.Usage()
+		os.Exit(2)
+	}
+
+	return compareFiles(os.Stdout, fs.Arg(0), fs.Arg(1))
+}
+
+// revision names the results of the current commit.
+func revision(root string) string {
+	cmd := exec.Command("git", "rev-parse", "--short", "HEAD")
+	cmd.Dir = root
+
+	b, err := cmd.Output()
+	if err != nil {
+		return time.Now().Format("20060102-150405")
+	}
+
+	return strings.TrimSpace(string(b))
+}
+
+// BenchResults holds the samples of the benchmarks in a go test output, by
+// day and benchmark, such as 2016/05/Part1.
+type BenchResults struct {
+	Names   []string
+	Samples map[string]map[string][]float64 // name, unit, samples
+}
+
+var benchLine = regexp.MustCompile(`^Benchmark(\S+?)(?:-\d+)?\s+\d+\s+(.*)$`)
+
+var benchPkg = regexp.MustCompile(`^pkg: .*/events/(\d{4}/\d{2})$`)
+
+func parseBenchmarks(r io.Reader) (*BenchResults, error) {
+	results := &BenchResults{Samples: make(map[string]map[string][]float64)}
+
+	var pkg string
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		if m := benchPkg.FindStringSubmatch(line); m != nil {
+			pkg = m[1]
+			continue
+		}
+
+		m := benchLine.FindStringSubmatch(line)
+		if m == nil {
+			continue
+		}
+
+		name := m[1]
+		if pkg != "" {
+			name = pkg + "/" + name
+		}
+
+		samples, ok := results.Samples[name]
+		if !ok {
+			samples = make(map[string][]float64)
+			results.Samples[name] = samples
+			results.Names = append(results.Names, name)
+		}
+
+		// the measurements come in value and unit pairs
+		fields := strings.Fields(m[2])
+		for i := 0; i+1 < len(fields); i += 2 {
+			v, err := strconv.ParseFloat(fields[i], 64)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", line, err)
+			}
+			samples[fields[i+1]] = append(samples[fields[i+1]], v)
+		}
+	}
+
+	return results, scanner.Err()
+}
+
+func readBenchmarks(path string) (*BenchResults, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return parseBenchmarks(f)
+}
+
+func compareFiles(out io.Writer, oldPath, newPath string) error {
+	old, err := readBenchmarks(oldPath)
+	if err != nil {
+		return err
+	}
+
+	cur, err := readBenchmarks(newPath)
+	if err != nil {
+		return err
+	}
+
+	compareBenchmarks(out, old, cur)
+	return nil
+}
+
+// Summary is the center and spread of the samples of a benchmark.
+type Summary struct {
+	Median, Min, Max float64
+}
+
+func summarize(samples []float64) Summary {
+	s := append([]float64(nil), samples...)
+	sort.Float64s(s)
+
+	n := len(s)
+	median := s[n/2]
+	if n%2 == 0 {
+		median = (s[n/2-1] + s[n/2]) / 2
+	}
+
+	return Summary{Median: median, Min: s[0], Max: s[n-1]}
+}
+
+// Spread is the largest deviation from the median in percent.
+func (s Summary) Spread() float64 {
+	if s.Median == 0 {
+		return 0
+	}
+	return math.Max(s.Max-s.Median, s.Median-s.Min) / s.Median * 100
+}
+
+// Overlaps reports whether the samples of two summaries overlap, in which
+// case the difference is not considered significant.
+func (s Summary) Overlaps(other Summary) bool {
+	return s.Min <= other.Max && other.Min <= s.Max
+}
+
+var benchUnits = []struct {
+	unit   string
+	header string
+	format func(float64) string
+}{
+	{"ns/op", "time/op", func(v float64) string { return formatNanos(v) }},
+	{"B/op", "alloc/op", func(v float64) string { return aoc.FormatBytes(uint64(v)) }},
+	{"allocs/op", "allocs/op", func(v float64) string { return strconv.FormatFloat(v, 'f', 0, 64) }},
+}
+
+// compareBenchmarks prints a table per unit in the style of benchstat.
+func compareBenchmarks(out io.Writer, old, cur *BenchResults) {
+	names := append([]string(nil), old.Names...)
+	for _, name := range cur.Names {
+		if _, ok := old.Samples[name]; !ok {
+			names = append(names, name)
+		}
+	}
+
+	for i, u := range benchUnits {
+		if i > 0 {
+			_, _ = fmt.Fprintln(out)
+		}
+
+		w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+		_, _ = fmt.Fprintf(w, "name\told %s\tnew %s\tdelta\t\n", u.header, u.header)
+
+		for _, name := range names {
+			before, after := old.Samples[name][u.unit], cur.Samples[name][u.unit]
+			if len(before) == 0 && len(after) == 0 {
+				continue
+			}
+
+			cells := []string{name, "", "", ""}
+
+			var so, sn Summary
+			if len(before) > 0 {
+				so = summarize(before)
+				cells[1] = fmt.Sprintf("%s ± %.0f%%", u.format(so.Median), so.Spread())
+			}
+			if len(after) > 0 {
+				sn = summarize(after)
+				cells[2] = fmt.Sprintf("%s ± %.0f%%", u.format(sn.Median), sn.Spread())
+			}
+
+			switch {
+			case len(before) == 0 || len(after) == 0:
+			case so.Overlaps(sn):
+				cells[3] = "~"
+			case so.Median == 0:
+				cells[3] = "+Inf%"
+			default:
+				cells[3] = fmt.Sprintf("%+.2f%%", (sn.Median-so.Median)/so.Median*100)
+			}
+
+			_, _ = fmt.Fprintln(w, strings.Join(cells, "\t")+"\t")
+		}
+
+		_ = w.Flush()
+	}
+}
+
+func formatNanos(ns float64) string {
+	d := time.Duration(ns)
+	switch {
+	case d >= time.Second:
+		return fmt.Sprintf("%.2fs", d.Seconds())
+	case d >= time.Millisecond:
+		return fmt.Sprintf("%.2fms", float64(d)/float64(time.Millisecond))
+	case d >= time.Microsecond:
+		return fmt.Sprintf("%.2fµs", float64(d)/float64(time.Microsecond))
+	default:
+		return fmt.Sprintf("%.2fns", ns)
+	}
+}
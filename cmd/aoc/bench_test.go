@@ -0,0 +1,68 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+const oldBench = `goos: linux
+goarch: amd64
+pkg: github.com/pimvanhespen/advent-of-code/events/2016/05
+BenchmarkParse-8   	 1000000	      1000 ns/op	     512 B/op	       2 allocs/op
+BenchmarkParse-8   	 1000000	      1100 ns/op	     512 B/op	       2 allocs/op
+BenchmarkPart1-8   	       1	2000000000 ns/op	 1048576 B/op	    1000 allocs/op
+BenchmarkPart1-8   	       1	2100000000 ns/op	 1048576 B/op	    1000 allocs/op
+PASS
+ok  	github.com/pimvanhespen/advent-of-code/events/2016/05	4.200s
+`
+
+const newBench = `pkg: github.com/pimvanhespen/advent-of-code/events/2016/05
+BenchmarkParse-8   	 1000000	      1050 ns/op	     512 B/op	       2 allocs/op
+BenchmarkParse-8   	 1000000	      1060 ns/op	     512 B/op	       2 allocs/op
+BenchmarkPart1-8   	       1	1000000000 ns/op	  524288 B/op	     500 allocs/op
+BenchmarkPart1-8   	       1	1000000000 ns/op	  524288 B/op	     500 allocs/op
+pkg: github.com/pimvanhespen/advent-of-code/events/2016/14
+BenchmarkPart2-8   	       1	3000000000 ns/op
+`
+
+func Test_parseBenchmarks(t *testing.T) {
+	results, err := parseBenchmarks(strings.NewReader(newBench))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{"2016/05/Parse", "2016/05/Part1", "2016/14/Part2"}
+	if strings.Join(results.Names, " ") != strings.Join(want, " ") {
+		t.Errorf("Names = %v, want %v", results.Names, want)
+	}
+
+	if got := results.Samples["2016/05/Part1"]["allocs/op"]; len(got) != 2 || got[0] != 500 {
+		t.Errorf("allocs/op = %v, want [500 500]", got)
+	}
+}
+
+func Test_compareBenchmarks(t *testing.T) {
+	old, _ := parseBenchmarks(strings.NewReader(oldBench))
+	cur, _ := parseBenchmarks(strings.NewReader(newBench))
+
+	var buf bytes.Buffer
+	compareBenchmarks(&buf, old, cur)
+
+	got := buf.String()
+	for _, want := range []string{
+		"name           old time/op  new time/op  delta",
+		"2016/05/Parse  1.05µs ± 5%  1.05µs ± 0%  ~",
+		"2016/05/Part1  2.05s ± 2%   1.00s ± 0%   -51.22%",
+		"2016/14/Part2               3.00s ± 0%",
+		"2016/05/Part1  1000 ± 0%      500 ± 0%       -50.00%",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("comparison does not contain %q:\n%s", want, got)
+		}
+	}
+
+	if strings.Count(got, "2016/14/Part2") != 1 {
+		t.Errorf("2016/14/Part2 should only be listed for time/op:\n%s", got)
+	}
+}
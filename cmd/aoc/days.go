@@ -0,0 +1,80 @@
+// Code generated by gendays.go; DO NOT EDIT.
+
+package main
+
+import (
+	_ "github.com/pimvanhespen/advent-of-code/events/2015/01"
+	_ "github.com/pimvanhespen/advent-of-code/events/2015/02"
+	_ "github.com/pimvanhespen/advent-of-code/events/2015/03"
+	_ "github.com/pimvanhespen/advent-of-code/events/2015/04"
+	_ "github.com/pimvanhespen/advent-of-code/events/2015/05"
+	_ "github.com/pimvanhespen/advent-of-code/events/2015/06"
+	_ "github.com/pimvanhespen/advent-of-code/events/2015/07"
+	_ "github.com/pimvanhespen/advent-of-code/events/2015/08"
+	_ "github.com/pimvanhespen/advent-of-code/events/2015/09"
+	_ "github.com/pimvanhespen/advent-of-code/events/2015/10"
+	_ "github.com/pimvanhespen/advent-of-code/events/2015/11"
+	_ "github.com/pimvanhespen/advent-of-code/events/2015/12"
+	_ "github.com/pimvanhespen/advent-of-code/events/2015/13"
+	_ "github.com/pimvanhespen/advent-of-code/events/2015/14"
+	_ "github.com/pimvanhespen/advent-of-code/events/2015/15"
+	_ "github.com/pimvanhespen/advent-of-code/events/2015/16"
+	_ "github.com/pimvanhespen/advent-of-code/events/2015/17"
+	_ "github.com/pimvanhespen/advent-of-code/events/2015/18"
+	_ "github.com/pimvanhespen/advent-of-code/events/2015/19"
+	_ "github.com/pimvanhespen/advent-of-code/events/2015/20"
+	_ "github.com/pimvanhespen/advent-of-code/events/2015/21"
+	_ "github.com/pimvanhespen/advent-of-code/events/2015/22"
+	_ "github.com/pimvanhespen/advent-of-code/events/2015/23"
+	_ "github.com/pimvanhespen/advent-of-code/events/2015/24"
+	_ "github.com/pimvanhespen/advent-of-code/events/2015/25"
+	_ "github.com/pimvanhespen/advent-of-code/events/2016/01"
+	_ "github.com/pimvanhespen/advent-of-code/events/2016/02"
+	_ "github.com/pimvanhespen/advent-of-code/events/2016/03"
+	_ "github.com/pimvanhespen/advent-of-code/events/2016/04"
+	_ "github.com/pimvanhespen/advent-of-code/events/2016/05"
+	_ "github.com/pimvanhespen/advent-of-code/events/2016/06"
+	_ "github.com/pimvanhespen/advent-of-code/events/2016/07"
+	_ "github.com/pimvanhespen/advent-of-code/events/2016/08"
+	_ "github.com/pimvanhespen/advent-of-code/events/2016/09"
+	_ "github.com/pimvanhespen/advent-of-code/events/2016/10"
+	_ "github.com/pimvanhespen/advent-of-code/events/2016/11"
+	_ "github.com/pimvanhespen/advent-of-code/events/2016/12"
+	_ "github.com/pimvanhespen/advent-of-code/events/2016/13"
+	_ "github.com/pimvanhespen/advent-of-code/events/2016/14"
+	_ "github.com/pimvanhespen/advent-of-code/events/2016/15"
+	_ "github.com/pimvanhespen/advent-of-code/events/2016/16"
+	_ "github.com/pimvanhespen/advent-of-code/events/2016/17"
+	_ "github.com/pimvanhespen/advent-of-code/events/2016/18"
+	_ "github.com/pimvanhespen/advent-of-code/events/2016/19"
+	_ "github.com/pimvanhespen/advent-of-code/events/2016/20"
+	_ "github.com/pimvanhespen/advent-of-code/events/2016/21"
+	_ "github.com/pimvanhespen/advent-of-code/events/2016/22"
+	_ "github.com/pimvanhespen/advent-of-code/events/2016/23"
+	_ "github.com/pimvanhespen/advent-of-code/events/2016/24"
+	_ "github.com/pimvanhespen/advent-of-code/events/2016/25"
+	_ "github.com/pimvanhespen/advent-of-code/events/2017/01"
+	_ "github.com/pimvanhespen/advent-of-code/events/2017/02"
+	_ "github.com/pimvanhespen/advent-of-code/events/2017/03"
+	_ "github.com/pimvanhespen/advent-of-code/events/2017/04"
+	_ "github.com/pimvanhespen/advent-of-code/events/2017/05"
+	_ "github.com/pimvanhespen/advent-of-code/events/2017/06"
+	_ "github.com/pimvanhespen/advent-of-code/events/2017/07"
+	_ "github.com/pimvanhespen/advent-of-code/events/2023/01"
+	_ "github.com/pimvanhespen/advent-of-code/events/2023/02"
+	_ "github.com/pimvanhespen/advent-of-code/events/2023/03"
+	_ "github.com/pimvanhespen/advent-of-code/events/2023/04"
+	_ "github.com/pimvanhespen/advent-of-code/events/2023/05"
+	_ "github.com/pimvanhespen/advent-of-code/events/2023/06"
+	_ "github.com/pimvanhespen/advent-of-code/events/2023/07"
+	_ "github.com/pimvanhespen/advent-of-code/events/2023/08"
+	_ "github.com/pimvanhespen/advent-of-code/events/2023/09"
+	_ "github.com/pimvanhespen/advent-of-code/events/2023/10"
+	_ "github.com/pimvanhespen/advent-of-code/events/2023/11"
+	_ "github.com/pimvanhespen/advent-of-code/events/2023/12"
+	_ "github.com/pimvanhespen/advent-of-code/events/2023/13"
+	_ "github.com/pimvanhespen/advent-of-code/events/2023/14"
+	_ "github.com/pimvanhespen/advent-of-code/events/2023/15"
+	_ "github.com/pimvanhespen/advent-of-code/events/2023/16"
+	_ "github.com/pimvanhespen/advent-of-code/events/2023/18"
+)
@@ -0,0 +1,97 @@
+//go:build ignore
+
+// gendays writes days.go, which imports every day under events/ that
+// registers itself with aoc.Register or aoc.RegisterContext. Run it with go
+// generate.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+const module = "github.com/pimvanhespen/advent-of-code"
+
+func main() {
+	root := filepath.Join("..", "..")
+
+	files, err := filepath.Glob(filepath.Join(root, "events", "*", "*", "main.go"))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var pkgs []string
+	for _, f := range files {
+		ok, err := registers(f)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if !ok {
+			continue
+		}
+
+		rel, err := filepath.Rel(root, filepath.Dir(f))
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		pkgs = append(pkgs, module+"/"+filepath.ToSlash(rel))
+	}
+
+	sort.Strings(pkgs)
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by gendays.go; DO NOT EDIT.\n\n")
+	buf.WriteString("package main\n\n")
+	buf.WriteString("import (\n")
+	for _, p := range pkgs {
+		_, _ = fmt.Fprintf(&buf, "\t_ %q\n", p)
+	}
+	buf.WriteString(")\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err = os.WriteFile("days.go", src, 0644); err != nil {
+		log.Fatal(err)
+	}
+
+	log.Printf("registered %d days", len(pkgs))
+}
+
+// registers reports whether the file calls aoc.Register or
+// aoc.RegisterContext.
+func registers(filename string) (bool, error) {
+	f, err := parser.ParseFile(token.NewFileSet(), filename, nil, 0)
+	if err != nil {
+		return false, err
+	}
+
+	var found bool
+	ast.Inspect(f, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return !found
+		}
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		if pkg, ok := sel.X.(*ast.Ident); ok && pkg.Name == "aoc" {
+			found = found || sel.Sel.Name == "Register" || sel.Sel.Name == "RegisterContext"
+		}
+		return !found
+	})
+
+	return found, nil
+}
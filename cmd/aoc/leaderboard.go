@@ -0,0 +1,235 @@
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/pimvanhespen/advent-of-code/pkg/aoc"
+)
+
+type LeaderboardConfig struct {
+	Group   uint
+	Year    uint
+	Day     uint
+	Scoring string
+	Format  string
+	Account string
+}
+
+func (c *LeaderboardConfig) Register(fs *flag.FlagSet) {
+	fs.UintVar(&c.Group, "group", 0, "id of the private leaderboard")
+	fs.UintVar(&c.Year, "year", uint(defaultYear()), "year of the event")
+	fs.StringVar(&c.Account, "account", "", "account in config.toml to use")
+}
+
+func (c *LeaderboardConfig) IsValid() bool {
+	return c.Group != 0 && c.Year >= 2015 && c.Day <= 25
+}
+
+// defaultYear returns the year of the most recent event.
+func defaultYear() int {
+	now := time.Now()
+	if now.Month() < time.December {
+		return now.Year() - 1
+	}
+	return now.Year()
+}
+
+func leaderboardCommand(args []string) error {
+	if len(args) > 0 && args[0] == "watch" {
+		return watchCommand(args[1:])
+	}
+
+	var c LeaderboardConfig
+
+	fs := flag.NewFlagSet("leaderboard", flag.ExitOnError)
+	c.Register(fs)
+	fs.UintVar(&c.Day, "day", 0, "show the star timeline of a single day instead of the standings")
+	fs.StringVar(&c.Scoring, "scoring", string(aoc.LocalScore), "ranking: local, stars or median")
+	fs.StringVar(&c.Format, "format", "table", "output format: table, csv or json")
+	_ = fs.Parse(args)
+
+	if !c.IsValid() {
+		fs.Usage()
+		os.Exit(2)
+	}
+
+	scoring, err := aoc.ParseScoring(c.Scoring)
+	if err != nil {
+		return err
+	}
+
+	client, err := aoc.NewAccountClient(c.Account)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	lb, err := client.GetLeaderboard(ctx, int(c.Year), int(c.Group))
+	if err != nil {
+		return err
+	}
+
+	var rows [][]string
+	var v any
+	if c.Day != 0 {
+		timeline := timelineRows(lb, int(c.Day))
+		rows, v = timelineTable(timeline), timeline
+	} else {
+		standings := standingRows(lb, scoring)
+		rows, v = standingTable(standings), standings
+	}
+
+	switch c.Format {
+	case "table":
+		return writeTable(os.Stdout, rows)
+	case "csv":
+		return csv.NewWriter(os.Stdout).WriteAll(rows)
+	case "json":
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(v)
+	default:
+		return fmt.Errorf("unknown format %q", c.Format)
+	}
+}
+
+type StandingRow struct {
+	Rank        int    `json:"rank"`
+	Name        string `json:"name"`
+	LocalScore  int    `json:"local_score"`
+	Stars       int    `json:"stars"`
+	MedianSolve int64  `json:"median_solve_seconds,omitempty"`
+	Days        []int  `json:"days"`
+}
+
+func standingRows(lb *aoc.Leaderboard, scoring aoc.Scoring) []StandingRow {
+	year, _ := lb.Year()
+
+	var rows []StandingRow
+	for _, s := range lb.Standings(scoring) {
+		row := StandingRow{
+			Rank:       s.Rank,
+			Name:       s.Member.DisplayName(),
+			LocalScore: s.Member.LocalScore,
+			Stars:      s.Member.Stars,
+			Days:       make([]int, 25),
+		}
+
+		if median, ok := s.Member.MedianSolveTime(year); ok {
+			row.MedianSolve = int64(median.Seconds())
+		}
+
+		for day := range row.Days {
+			row.Days[day] = s.Member.StarsOn(day + 1)
+		}
+
+		rows = append(rows, row)
+	}
+
+	return rows
+}
+
+func standingTable(standings []StandingRow) [][]string {
+	rows := [][]string{{"RANK", "NAME", "SCORE", "STARS", "MEDIAN", "DAYS 1-25"}}
+
+	for _, s := range standings {
+		var days strings.Builder
+		for _, stars := range s.Days {
+			days.WriteByte(".+*"[stars])
+		}
+
+		rows = append(rows, []string{
+			strconv.Itoa(s.Rank),
+			s.Name,
+			strconv.Itoa(s.LocalScore),
+			strconv.Itoa(s.Stars),
+			formatSeconds(s.MedianSolve),
+			days.String(),
+		})
+	}
+
+	return rows
+}
+
+type TimelineRow struct {
+	Name  string     `json:"name"`
+	Part1 *time.Time `json:"part1,omitempty"`
+	Part2 *time.Time `json:"part2,omitempty"`
+	// Solve1 and Solve2 are the seconds between unlock and star.
+	Solve1 int64 `json:"part1_seconds,omitempty"`
+	Solve2 int64 `json:"part2_seconds,omitempty"`
+	// Delta is the number of seconds between the first and second star.
+	Delta int64 `json:"delta_seconds,omitempty"`
+}
+
+func timelineRows(lb *aoc.Leaderboard, day int) []TimelineRow {
+	year, _ := lb.Year()
+	unlock := aoc.Unlock(year, day)
+
+	var rows []TimelineRow
+	for _, s := range lb.Standings(aoc.LocalScore) {
+		level, ok := s.Member.CompletionDayLevel[day]
+		if !ok {
+			continue
+		}
+
+		row := TimelineRow{Name: s.Member.DisplayName()}
+
+		if level.Part1 != nil {
+			t := level.Part1.Time()
+			row.Part1, row.Solve1 = &t, int64(t.Sub(unlock).Seconds())
+		}
+		if level.Part2 != nil {
+			t := level.Part2.Time()
+			row.Part2, row.Solve2 = &t, int64(t.Sub(unlock).Seconds())
+		}
+		if delta, ok := level.Delta(); ok {
+			row.Delta = int64(delta.Seconds())
+		}
+
+		rows = append(rows, row)
+	}
+
+	// order by the moment of the first star
+	sort.SliceStable(rows, func(i, j int) bool {
+		return rows[i].Solve1 < rows[j].Solve1
+	})
+
+	return rows
+}
+
+func timelineTable(timeline []TimelineRow) [][]string {
+	rows := [][]string{{"NAME", "PART 1", "PART 2", "DELTA"}}
+	for _, t := range timeline {
+		rows = append(rows, []string{t.Name, formatSeconds(t.Solve1), formatSeconds(t.Solve2), formatSeconds(t.Delta)})
+	}
+	return rows
+}
+
+func formatSeconds(s int64) string {
+	if s == 0 {
+		return "-"
+	}
+	return (time.Duration(s) * time.Second).String()
+}
+
+func writeTable(out io.Writer, rows [][]string) error {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	for _, row := range rows {
+		_, _ = fmt.Fprintln(w, strings.Join(row, "\t")+"\t")
+	}
+	return w.Flush()
+}
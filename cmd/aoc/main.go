@@ -0,0 +1,55 @@
+//go:generate go run gendays.go
+
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+type Command struct {
+	Name  string
+	Usage string
+	Run   func(args []string) error
+}
+
+var commands = []Command{
+	{Name: "run", Usage: "run registered solutions", Run: runCommand},
+	{Name: "verify", Usage: "check solutions against their accepted answers", Run: verifyCommand},
+	{Name: "bench", Usage: "benchmark solutions, or compare results (bench compare OLD NEW)", Run: benchCommand},
+	{Name: "submit", Usage: "submit the answer of a part", Run: submitCommand},
+	{Name: "whoami", Usage: "show the user the session belongs to", Run: whoamiCommand},
+	{Name: "leaderboard", Usage: "show or watch (leaderboard watch) a private leaderboard", Run: leaderboardCommand},
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+
+	for _, c := range commands {
+		if c.Name != os.Args[1] {
+			continue
+		}
+
+		if err := c.Run(os.Args[2:]); err != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "error: %v\n", err)
+			os.Exit(1)
+		}
+
+		os.Exit(0)
+	}
+
+	usage()
+	os.Exit(2)
+}
+
+func usage() {
+	_, _ = fmt.Fprintln(os.Stderr, "usage: aoc <command> [flags]")
+	_, _ = fmt.Fprintln(os.Stderr)
+	_, _ = fmt.Fprintln(os.Stderr, "commands:")
+	for _, c := range commands {
+		_, _ = fmt.Fprintf(os.Stderr, "  %-12s %s\n", c.Name, c.Usage)
+	}
+}
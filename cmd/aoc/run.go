@@ -0,0 +1,426 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/pimvanhespen/advent-of-code/pkg/aoc"
+)
+
+// Selection determines which registered solutions a command operates on.
+type Selection struct {
+	Year uint
+	Day  uint
+	Part uint
+	All  bool
+}
+
+func (s *Selection) Register(fs *flag.FlagSet) {
+	fs.UintVar(&s.Year, "year", 0, "year of the event")
+	fs.UintVar(&s.Day, "day", 0, "day of the event (1-25), requires -year")
+	fs.UintVar(&s.Part, "part", 0, "part to run (1 or 2), default all parts")
+	fs.BoolVar(&s.All, "all", false, "select every registered day")
+}
+
+func (s *Selection) IsValid() bool {
+	switch {
+	case s.All:
+		return s.Year == 0 && s.Day == 0
+	case s.Day != 0:
+		return s.Year != 0 && s.Day <= 25
+	default:
+		return s.Year != 0
+	}
+}
+
+// Solutions returns the registered solutions matching the selection.
+func (s *Selection) Solutions() ([]*aoc.Solution, error) {
+	if s.Day != 0 {
+		solution, err := aoc.Lookup(int(s.Year), int(s.Day))
+		if err != nil {
+			return nil, err
+		}
+		return []*aoc.Solution{solution}, nil
+	}
+
+	var result []*aoc.Solution
+	for _, solution := range aoc.Solutions() {
+		if s.All || solution.Challenge.Year == int(s.Year) {
+			result = append(result, solution)
+		}
+	}
+
+	if len(result) == 0 {
+		return nil, fmt.Errorf("year %d: %w", s.Year, aoc.ErrNoSolution)
+	}
+
+	return result, nil
+}
+
+// Parts returns the parts of the solution matching the selection.
+func (s *Selection) Parts(solution *aoc.Solution) []int {
+	if s.Part != 0 {
+		return []int{int(s.Part)}
+	}
+
+	parts := make([]int, solution.Parts())
+	for i := range parts {
+		parts[i] = i + 1
+	}
+	return parts
+}
+
+// RunConfig holds the flags that control how solutions are run.
+type RunConfig struct {
+	Verbose      bool
+	LogFormat    string
+	Offline      bool
+	Repeat       int
+	Jobs         int
+	Timeout      time.Duration
+	Input        string
+	CPUProfile   bool
+	MemProfile   bool
+	Trace        bool
+	ProfilePart  uint
+	Report       string
+	ReportFormat string
+
+	reporter aoc.Reporter
+	report   *os.File
+}
+
+func (c *RunConfig) Register(fs *flag.FlagSet) {
+	fs.BoolVar(&c.Verbose, "v", false, "show solver logging")
+	fs.StringVar(&c.LogFormat, "log-format", "text", "log format: text or json")
+	fs.BoolVar(&c.Offline, "offline", false, "never download missing inputs (or set AOC_OFFLINE=1)")
+	fs.IntVar(&c.Repeat, "repeat", 1, "solve every part `N` times and report min, median and p95")
+	fs.IntVar(&c.Jobs, "j", 1, "run up to `N` days at the same time, timings and allocations are less precise with more than one")
+	fs.DurationVar(&c.Timeout, "timeout", 0, "stop a part after this long, 0 means no limit")
+	fs.BoolVar(&c.CPUProfile, "cpuprofile", false, "write a CPU profile of every part to the profiles directory of the day")
+	fs.BoolVar(&c.MemProfile, "memprofile", false, "write an allocation profile of every part to the profiles directory of the day")
+	fs.BoolVar(&c.Trace, "trace", false, "write an execution trace of every part to the profiles directory of the day")
+	fs.UintVar(&c.ProfilePart, "profile-part", 0, "profile only this part (1 or 2), default every part that runs")
+	fs.StringVar(&c.Report, "report", "", "write a report of every part to this `file`")
+	fs.StringVar(&c.ReportFormat, "report-format", "json", "report format: json (lines) or junit")
+}
+
+// OpenReport creates the report file, if any. Close must be called after the
+// last part is solved.
+func (c *RunConfig) OpenReport() error {
+	if c.Report == "" {
+		return nil
+	}
+
+	f, err := os.Create(c.Report)
+	if err != nil {
+		return err
+	}
+
+	c.reporter, err = aoc.NewReporter(f, c.ReportFormat)
+	if err != nil {
+		_ = f.Close()
+		return err
+	}
+
+	c.report = f
+	return nil
+}
+
+// Close writes and closes the report, it is safe to call more than once.
+func (c *RunConfig) Close() error {
+	if c.report == nil {
+		return nil
+	}
+
+	err := c.reporter.Close()
+	if cerr := c.report.Close(); err == nil {
+		err = cerr
+	}
+	c.report = nil
+
+	return err
+}
+
+// IsValid reports whether the flags hold known values.
+func (c *RunConfig) IsValid() bool {
+	return (c.LogFormat == "text" || c.LogFormat == "json") && c.ProfilePart <= 2
+}
+
+// Level is the log level for the flags.
+func (c *RunConfig) Level() slog.Level {
+	if c.Verbose {
+		return slog.LevelInfo
+	}
+	return slog.LevelWarn
+}
+
+// Logger returns a logger to out in the log format and level of the flags.
+func (c *RunConfig) Logger(out io.Writer) *slog.Logger {
+	if c.LogFormat == "json" {
+		return slog.New(slog.NewJSONHandler(out, &slog.HandlerOptions{Level: c.Level()}))
+	}
+	return slog.New(aoc.NewLogHandler(out, c.Level()))
+}
+
+// SetDefaultLogger applies the log format and level to solvers that still log
+// through the default logger instead of aoc.Logger.
+func (c *RunConfig) SetDefaultLogger() {
+	slog.SetDefault(c.Logger(os.Stderr))
+}
+
+// Options returns the runner options for the flags, with out for the logs and
+// progress of the runner.
+func (c *RunConfig) Options(out io.Writer) []aoc.Option {
+	opts := []aoc.Option{aoc.WithOutput(out), aoc.WithLogger(c.Logger(out)), aoc.WithTimeout(c.Timeout)}
+
+	if c.Offline {
+		opts = append(opts, aoc.WithOffline())
+	}
+
+	if c.reporter != nil {
+		opts = append(opts, aoc.WithReporter(c.reporter))
+	}
+
+	if c.CPUProfile {
+		opts = append(opts, aoc.WithProfile(aoc.CPUProfile))
+	}
+	if c.MemProfile {
+		opts = append(opts, aoc.WithProfile(aoc.MemProfile))
+	}
+	if c.Trace {
+		opts = append(opts, aoc.WithProfile(aoc.TraceProfile))
+	}
+	if c.ProfilePart != 0 {
+		opts = append(opts, aoc.WithProfilePart(int(c.ProfilePart)))
+	}
+
+	switch {
+	case c.Input == "":
+	case strings.ContainsRune(c.Input, '/') || strings.ContainsRune(c.Input, filepath.Separator):
+		opts = append(opts, aoc.WithInputFile(c.Input))
+	default:
+		opts = append(opts, aoc.WithNamedInput(c.Input))
+	}
+
+	return opts
+}
+
+// Result is the outcome of parsing (part 0) or solving a part.
+type Result struct {
+	Challenge aoc.Challenge
+	Part      int
+	Answer    string
+	Stats     aoc.Stats
+	Err       error
+}
+
+func runCommand(args []string) error {
+	var sel Selection
+	var rc RunConfig
+
+	fs := flag.NewFlagSet("run", flag.ExitOnError)
+	sel.Register(fs)
+	rc.Register(fs)
+	fs.StringVar(&rc.Input, "input", "", "solve for a named input of the day (example1, input-alice) or a file path")
+	_ = fs.Parse(args)
+
+	if !sel.IsValid() || !rc.IsValid() {
+		fs.Usage()
+		os.Exit(2)
+	}
+
+	rc.SetDefaultLogger()
+
+	solutions, err := sel.Solutions()
+	if err != nil {
+		return err
+	}
+
+	if err = rc.OpenReport(); err != nil {
+		return err
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	results := runAll(ctx, solutions, &sel, &rc)
+
+	if err = rc.Close(); err != nil {
+		return fmt.Errorf("report: %w", err)
+	}
+
+	printResults(results, rc.Repeat > 1)
+	printPanics(os.Stderr, results)
+
+	var failed, parts int
+	for _, r := range results {
+		if r.Part == 0 {
+			continue
+		}
+		parts++
+		if r.Err != nil {
+			failed++
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d parts failed", failed, parts)
+	}
+
+	return nil
+}
+
+// runAll runs the selected parts of the solutions on up to rc.Jobs days at a
+// time and returns the results in the order of the solutions. The parts of a
+// day run one after the other, as they share the parsed input. When days run
+// concurrently their logs are collected and printed once all are done.
+func runAll(ctx context.Context, solutions []*aoc.Solution, sel *Selection, rc *RunConfig) []Result {
+	jobs := max(rc.Jobs, 1)
+	if rc.CPUProfile || rc.MemProfile || rc.Trace {
+		// the runtime records only one CPU profile or trace at a time and
+		// the allocations of the whole process
+		jobs = 1
+	}
+
+	results := make([][]Result, len(solutions))
+	logs := make([]bytes.Buffer, len(solutions))
+
+	sem := make(chan struct{}, jobs)
+	var wg sync.WaitGroup
+
+	for i, solution := range solutions {
+		var out io.Writer = os.Stderr
+		if jobs > 1 {
+			out = &logs[i]
+		}
+		opts := rc.Options(out)
+
+		sem <- struct{}{}
+		wg.Add(1)
+
+		go func(i int, solution *aoc.Solution) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			results[i] = run(ctx, solution, sel.Parts(solution), rc.Repeat, opts...)
+		}(i, solution)
+	}
+
+	wg.Wait()
+
+	var all []Result
+	for i, solution := range solutions {
+		if logs[i].Len() > 0 {
+			_, _ = fmt.Fprintf(os.Stderr, "== %s ==\n%s", solution.Challenge, logs[i].Bytes())
+		}
+		all = append(all, results[i]...)
+	}
+
+	return all
+}
+
+// run parses the input of a solution once and solves the given parts.
+// The first result holds the parse statistics.
+func run(ctx context.Context, solution *aoc.Solution, parts []int, repeat int, opts ...aoc.Option) []Result {
+	runner := solution.Runner(opts...)
+
+	parsed := Result{Challenge: solution.Challenge}
+	parsed.Stats, parsed.Err = runner.Parse()
+
+	results := []Result{parsed}
+	for _, part := range parts {
+		result := Result{Challenge: solution.Challenge, Part: part}
+		result.Answer, result.Stats, result.Err = runner.Solve(ctx, part, repeat)
+		results = append(results, result)
+	}
+
+	return results
+}
+
+// printPanics prints the stack traces of the parts that panicked. A panic
+// of the parser fails every part with the same error, it is printed once.
+func printPanics(out io.Writer, results []Result) {
+	printed := make(map[*aoc.PanicError]bool)
+	for _, r := range results {
+		var perr *aoc.PanicError
+		if !errors.As(r.Err, &perr) || printed[perr] {
+			continue
+		}
+		printed[perr] = true
+
+		_, _ = fmt.Fprintf(out, "\n%s %s: %s\n%s", r.Challenge, formatPart(r.Part), perr, perr.Stack)
+	}
+}
+
+func printResults(results []Result, repeated bool) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+
+	if repeated {
+		_, _ = fmt.Fprintln(w, "DAY\tPART\tANSWER\tMIN\tMEDIAN\tP95\tALLOCS\tBYTES\t")
+	} else {
+		_, _ = fmt.Fprintln(w, "DAY\tPART\tANSWER\tTIME\tALLOCS\tBYTES\t")
+	}
+
+	var total time.Duration
+	for _, r := range results {
+		total += r.Stats.Median
+
+		answer := formatAnswer(r.Answer)
+		if r.Err != nil {
+			answer = "error: " + r.Err.Error()
+		}
+
+		timing := formatDuration(r.Stats.Median)
+		if repeated {
+			timing = fmt.Sprintf("%s\t%s\t%s", formatDuration(r.Stats.Min), timing, formatDuration(r.Stats.P95))
+		}
+
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d\t%s\t\n", r.Challenge, formatPart(r.Part), answer, timing, r.Stats.Allocs, aoc.FormatBytes(r.Stats.Bytes))
+	}
+
+	if repeated {
+		_, _ = fmt.Fprintf(w, "\t\t\t\t%s\t\t\t\t\n", formatDuration(total))
+	} else {
+		_, _ = fmt.Fprintf(w, "\t\t\t%s\t\t\t\n", formatDuration(total))
+	}
+
+	_ = w.Flush()
+}
+
+// formatPart names the parse step, which is reported as part 0.
+func formatPart(part int) string {
+	if part == 0 {
+		return "parse"
+	}
+	return strconv.Itoa(part)
+}
+
+// formatDuration rounds to microseconds, unless that would hide a fast part.
+func formatDuration(d time.Duration) string {
+	if d < time.Millisecond {
+		return d.String()
+	}
+	return d.Round(time.Microsecond).String()
+}
+
+// formatAnswer keeps multi-line answers, such as rendered displays, on one table row.
+func formatAnswer(answer string) string {
+	if !strings.ContainsAny(answer, "\r\n\t") {
+		return answer
+	}
+	return strconv.Quote(answer)
+}
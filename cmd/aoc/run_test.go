@@ -0,0 +1,90 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pimvanhespen/advent-of-code/pkg/aoc"
+)
+
+func Test_runAll(t *testing.T) {
+	parse := func(r io.Reader) (int, error) {
+		b, err := io.ReadAll(r)
+		if err != nil {
+			return 0, err
+		}
+		return strconv.Atoi(string(b[:len(b)-1]))
+	}
+
+	// later days finish first
+	for day := 1; day <= 5; day++ {
+		delay := time.Duration(5-day) * 5 * time.Millisecond
+		solve := func(n int) string {
+			time.Sleep(delay)
+			return strconv.Itoa(n * 2)
+		}
+		aoc.Register(1998, day, parse, solve, solve)
+	}
+
+	input := filepath.Join(t.TempDir(), "input.txt")
+	if err := os.WriteFile(input, []byte("21\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	sel := Selection{Year: 1998}
+	rc := RunConfig{Jobs: 3, Input: input}
+
+	solutions, err := sel.Solutions()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	results := runAll(context.Background(), solutions, &sel, &rc)
+	if len(results) != 15 {
+		t.Fatalf("got %d results, want 15", len(results))
+	}
+
+	for i, r := range results {
+		day, part := i/3+1, i%3
+		if r.Challenge.Day != day || r.Part != part {
+			t.Errorf("result %d is day %d part %d, want day %d part %d", i, r.Challenge.Day, r.Part, day, part)
+		}
+		if r.Err != nil {
+			t.Errorf("day %d part %d: %v", day, part, r.Err)
+		}
+		if part != 0 && r.Answer != "42" {
+			t.Errorf("day %d part %d = %q, want 42", day, part, r.Answer)
+		}
+	}
+}
+
+func Test_printPanics(t *testing.T) {
+	c := aoc.NewChallenge(1998, 6)
+	parsing := &aoc.PanicError{Value: "parse", Stack: []byte("parse stack\n")}
+	solving := &aoc.PanicError{Value: "solve", Stack: []byte("solve stack\n")}
+
+	// a panic of the parser fails every part
+	results := []Result{
+		{Challenge: c, Err: parsing},
+		{Challenge: c, Part: 1, Err: parsing},
+		{Challenge: c, Part: 2, Err: fmt.Errorf("part 2: %w", parsing)},
+		{Challenge: aoc.NewChallenge(1998, 7), Part: 1, Err: solving},
+	}
+
+	var out bytes.Buffer
+	printPanics(&out, results)
+
+	for _, stack := range []string{"parse stack", "solve stack"} {
+		if n := strings.Count(out.String(), stack); n != 1 {
+			t.Errorf("printPanics() printed %q %d times, want once:\n%s", stack, n, out.String())
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/pimvanhespen/advent-of-code/pkg/aoc"
+)
+
+type SubmitConfig struct {
+	Year    uint
+	Day     uint
+	Part    uint
+	Answer  string
+	Account string
+}
+
+func (c SubmitConfig) IsValid() bool {
+	return c.Year >= 2015 && c.Day >= 1 && c.Day <= 25 && (c.Part == 1 || c.Part == 2)
+}
+
+func submitCommand(args []string) error {
+	var c SubmitConfig
+
+	fs := flag.NewFlagSet("submit", flag.ExitOnError)
+	fs.UintVar(&c.Year, "year", 0, "year of the event")
+	fs.UintVar(&c.Day, "day", 0, "day of the event (1-25)")
+	fs.UintVar(&c.Part, "part", 0, "part to submit (1 or 2)")
+	fs.StringVar(&c.Answer, "answer", "", "answer to submit instead of running the solution")
+	fs.StringVar(&c.Account, "account", "", "account in config.toml to submit with")
+	_ = fs.Parse(args)
+
+	if !c.IsValid() {
+		fs.Usage()
+		os.Exit(2)
+	}
+
+	challenge := aoc.NewChallenge(int(c.Year), int(c.Day))
+
+	answer := c.Answer
+	if answer == "" {
+		solution, err := aoc.Lookup(challenge.Year, challenge.Day)
+		if err != nil {
+			return err
+		}
+
+		results := run(context.Background(), solution, []int{int(c.Part)}, 1, aoc.WithOutput(os.Stderr))
+		result := results[len(results)-1]
+		if result.Err != nil {
+			return result.Err
+		}
+
+		answer = result.Answer
+	}
+
+	client, err := aoc.NewAccountClient(c.Account)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	_, _ = fmt.Printf("%s part %d: submitting %s\n", challenge, c.Part, formatAnswer(answer))
+
+	result, err := challenge.Submit(ctx, client, int(c.Part), answer)
+	if err != nil {
+		return err
+	}
+
+	_, _ = fmt.Println(result.Message)
+
+	if result.Status != aoc.Correct {
+		return errors.New(result.String())
+	}
+
+	return nil
+}
@@ -0,0 +1,168 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/pimvanhespen/advent-of-code/pkg/aoc"
+)
+
+type Verification struct {
+	Result
+	Want    string
+	Verdict aoc.Verdict
+}
+
+func verifyCommand(args []string) error {
+	var sel Selection
+	var rc RunConfig
+	var record bool
+
+	fs := flag.NewFlagSet("verify", flag.ExitOnError)
+	sel.Register(fs)
+	rc.Register(fs)
+	fs.BoolVar(&record, "record", false, "store the answers of MISSING parts as accepted answers")
+	_ = fs.Parse(args)
+
+	if !sel.IsValid() || !rc.IsValid() {
+		fs.Usage()
+		os.Exit(2)
+	}
+
+	rc.SetDefaultLogger()
+
+	solutions, err := sel.Solutions()
+	if err != nil {
+		return err
+	}
+
+	if err = rc.OpenReport(); err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	var verifications []Verification
+	for _, result := range runAll(ctx, solutions, &sel, &rc) {
+		if result.Part == 0 {
+			continue
+		}
+
+		answers, err := result.Challenge.Answers()
+		if err != nil {
+			return fmt.Errorf("%s: %w", result.Challenge, err)
+		}
+
+		v := verify(answers, result)
+
+		if record && v.Verdict == aoc.Missing {
+			if err = result.Challenge.StoreAnswer(v.Part, v.Answer); err != nil {
+				return fmt.Errorf("%s: %w", result.Challenge, err)
+			}
+		}
+
+		verifications = append(verifications, v)
+	}
+
+	if err = rc.Close(); err != nil {
+		return fmt.Errorf("report: %w", err)
+	}
+
+	printVerifications(os.Stdout, verifications)
+
+	results := make([]Result, len(verifications))
+	for i, v := range verifications {
+		results[i] = v.Result
+	}
+	printPanics(os.Stderr, results)
+
+	counts := make(map[aoc.Verdict]int)
+	for _, v := range verifications {
+		counts[v.Verdict]++
+	}
+
+	_, _ = fmt.Printf("\n%d passed, %d failed, %d missing, %d errors\n", counts[aoc.Pass], counts[aoc.Fail], counts[aoc.Missing], counts[aoc.Error])
+
+	if counts[aoc.Fail] > 0 || counts[aoc.Error] > 0 {
+		return fmt.Errorf("%d of %d parts did not pass", counts[aoc.Fail]+counts[aoc.Error], len(verifications))
+	}
+
+	return nil
+}
+
+func verify(answers aoc.Answers, result Result) Verification {
+	v := Verification{Result: result}
+	v.Want, _ = answers.Get(result.Part)
+
+	if result.Err != nil {
+		v.Verdict = aoc.Error
+		return v
+	}
+
+	v.Verdict = answers.Verify(result.Part, result.Answer)
+	return v
+}
+
+func printVerifications(out io.Writer, verifications []Verification) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+
+	_, _ = fmt.Fprintln(w, "DAY\tPART\tSTATUS\tANSWER\tTIME\t")
+
+	for _, v := range verifications {
+		answer := formatAnswer(v.Answer)
+		if v.Err != nil {
+			answer = "error: " + v.Err.Error()
+		}
+
+		_, _ = fmt.Fprintf(w, "%s\t%d\t%s\t%s\t%s\t\n", v.Challenge, v.Part, v.Verdict, answer, formatDuration(v.Stats.Median))
+	}
+
+	_ = w.Flush()
+
+	for _, v := range verifications {
+		if v.Verdict != aoc.Fail {
+			continue
+		}
+
+		_, _ = fmt.Fprintf(out, "\n%s part %d:\n%s", v.Challenge, v.Part, diff(v.Want, v.Answer))
+	}
+}
+
+// diff renders a line based comparison of the expected and actual answer.
+func diff(want, got string) string {
+	wantLines := strings.Split(want, "\n")
+	gotLines := strings.Split(got, "\n")
+
+	var sb strings.Builder
+	for i := 0; i < max(len(wantLines), len(gotLines)); i++ {
+		var w, g string
+		if i < len(wantLines) {
+			w = wantLines[i]
+		}
+		if i < len(gotLines) {
+			g = gotLines[i]
+		}
+
+		if w == g {
+			_, _ = fmt.Fprintf(&sb, "  %s\n", w)
+			continue
+		}
+
+		if i < len(wantLines) {
+			_, _ = fmt.Fprintf(&sb, "- %s\n", w)
+		}
+		if i < len(gotLines) {
+			_, _ = fmt.Fprintf(&sb, "+ %s\n", g)
+		}
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,335 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/pimvanhespen/advent-of-code/pkg/aoc"
+)
+
+// minInterval is the shortest polling interval the site allows for leaderboards.
+const minInterval = 15 * time.Minute
+
+type WatchConfig struct {
+	LeaderboardConfig
+	Interval      time.Duration
+	State         string
+	Log           string
+	Webhook       string
+	WebhookFormat string
+	Once          bool
+}
+
+// Snapshot is the last fetched leaderboard, stored between runs.
+type Snapshot struct {
+	Fetched     time.Time        `json:"fetched"`
+	Leaderboard *aoc.Leaderboard `json:"leaderboard"`
+}
+
+// Sink receives the events of a leaderboard.
+type Sink interface {
+	Send(ctx context.Context, events []aoc.StarEvent) error
+}
+
+func watchCommand(args []string) error {
+	var c WatchConfig
+
+	fs := flag.NewFlagSet("leaderboard watch", flag.ExitOnError)
+	c.Register(fs)
+	fs.DurationVar(&c.Interval, "interval", minInterval, "time between fetches, at least 15m")
+	fs.StringVar(&c.State, "state", "", "file to store the last snapshot in (default in the user cache directory)")
+	fs.StringVar(&c.Log, "log", "", "append events as JSON lines to this file")
+	fs.StringVar(&c.Webhook, "webhook", "", "post events to this URL")
+	fs.StringVar(&c.WebhookFormat, "webhook-format", "slack", "webhook payload: slack, discord or json")
+	fs.BoolVar(&c.Once, "once", false, "fetch once and exit, unless the interval since the last fetch has not passed")
+	_ = fs.Parse(args)
+
+	if !c.IsValid() {
+		fs.Usage()
+		os.Exit(2)
+	}
+
+	if c.Interval < minInterval {
+		log.Printf("interval %s is too short, using %s", c.Interval, minInterval)
+		c.Interval = minInterval
+	}
+
+	if c.State == "" {
+		dir, err := os.UserCacheDir()
+		if err != nil {
+			return err
+		}
+		c.State = filepath.Join(dir, "aoc", fmt.Sprintf("leaderboard-%d-%d.json", c.Year, c.Group))
+	}
+
+	sinks := []Sink{WriterSink{Out: os.Stdout}}
+
+	if c.Log != "" {
+		f, err := os.OpenFile(c.Log, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		sinks = append(sinks, JSONLinesSink{Out: f})
+	}
+
+	if c.Webhook != "" {
+		hook, err := NewWebhookSink(http.DefaultClient, c.Webhook, c.WebhookFormat)
+		if err != nil {
+			return err
+		}
+		sinks = append(sinks, hook)
+	}
+
+	client, err := aoc.NewAccountClient(c.Account)
+	if err != nil {
+		return err
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	fetch := func(ctx context.Context) (*aoc.Leaderboard, error) {
+		return client.GetLeaderboard(ctx, int(c.Year), int(c.Group))
+	}
+
+	w := &Watcher{Fetch: fetch, State: c.State, Interval: c.Interval, Sinks: sinks}
+
+	if c.Once {
+		return w.Poll(ctx)
+	}
+
+	err = w.Run(ctx)
+	if errors.Is(err, context.Canceled) {
+		return nil
+	}
+	return err
+}
+
+// Watcher polls a leaderboard and sends the stars earned since the last poll to its sinks.
+type Watcher struct {
+	Fetch    func(context.Context) (*aoc.Leaderboard, error)
+	State    string
+	Interval time.Duration
+	Sinks    []Sink
+}
+
+func (w *Watcher) Run(ctx context.Context) error {
+	for {
+		snapshot, err := w.load()
+		if err != nil {
+			return err
+		}
+
+		if snapshot != nil {
+			if err = sleepCtx(ctx, time.Until(snapshot.Fetched.Add(w.Interval))); err != nil {
+				return err
+			}
+		}
+
+		if err = w.Poll(ctx); err != nil {
+			// the next poll may succeed
+			log.Println("poll:", err)
+			if err = sleepCtx(ctx, w.Interval); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// Poll fetches the leaderboard once, reports the new stars and stores the snapshot.
+// The first poll only stores the snapshot. Polls sooner than the interval after
+// the last fetch do nothing, so runs from cron respect the rate limit.
+//
+// The snapshot is stored before the events are sent: a failing sink misses the
+// events instead of repeating them on the other sinks at the next poll.
+func (w *Watcher) Poll(ctx context.Context) error {
+	previous, err := w.load()
+	if err != nil {
+		return err
+	}
+
+	if previous != nil {
+		if next := previous.Fetched.Add(w.Interval); time.Now().Before(next) {
+			log.Printf("skipping poll, the next is due at %s", next.Format(time.DateTime))
+			return nil
+		}
+	}
+
+	current, err := w.Fetch(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err = w.store(Snapshot{Fetched: time.Now(), Leaderboard: current}); err != nil {
+		return err
+	}
+
+	if previous == nil {
+		return nil
+	}
+
+	events := current.NewStars(previous.Leaderboard)
+	if len(events) == 0 {
+		return nil
+	}
+
+	var errs []error
+	for _, s := range w.Sinks {
+		if err = s.Send(ctx, events); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (w *Watcher) load() (*Snapshot, error) {
+	b, err := os.ReadFile(w.State)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var s Snapshot
+	if err = json.Unmarshal(b, &s); err != nil {
+		return nil, fmt.Errorf("%s: %w", w.State, err)
+	}
+
+	return &s, nil
+}
+
+func (w *Watcher) store(s Snapshot) error {
+	if err := os.MkdirAll(filepath.Dir(w.State), 0755); err != nil {
+		return err
+	}
+
+	b, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(w.State, b, 0644)
+}
+
+func sleepCtx(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+// WriterSink prints one line per event.
+type WriterSink struct {
+	Out io.Writer
+}
+
+func (s WriterSink) Send(_ context.Context, events []aoc.StarEvent) error {
+	for _, e := range events {
+		if _, err := fmt.Fprintf(s.Out, "%s %s\n", e.Time.Format(time.DateTime), e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// JSONLinesSink writes one JSON object per event.
+type JSONLinesSink struct {
+	Out io.Writer
+}
+
+func (s JSONLinesSink) Send(_ context.Context, events []aoc.StarEvent) error {
+	enc := json.NewEncoder(s.Out)
+	for _, e := range events {
+		if err := enc.Encode(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WebhookSink posts the events to a chat webhook.
+type WebhookSink struct {
+	client  *http.Client
+	url     string
+	payload func([]aoc.StarEvent) any
+}
+
+func NewWebhookSink(client *http.Client, url, format string) (*WebhookSink, error) {
+	text := func(events []aoc.StarEvent) string {
+		lines := make([]string, len(events))
+		for i, e := range events {
+			lines[i] = e.String()
+		}
+		return strings.Join(lines, "\n")
+	}
+
+	var payload func([]aoc.StarEvent) any
+	switch format {
+	case "slack":
+		payload = func(events []aoc.StarEvent) any {
+			return map[string]string{"text": text(events)}
+		}
+	case "discord":
+		payload = func(events []aoc.StarEvent) any {
+			return map[string]string{"content": text(events)}
+		}
+	case "json":
+		payload = func(events []aoc.StarEvent) any {
+			return map[string][]aoc.StarEvent{"events": events}
+		}
+	default:
+		return nil, fmt.Errorf("unknown webhook format %q", format)
+	}
+
+	return &WebhookSink{client: client, url: url, payload: payload}, nil
+}
+
+func (s *WebhookSink) Send(ctx context.Context, events []aoc.StarEvent) error {
+	b, err := json.Marshal(s.payload(events))
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("webhook: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("webhook: status code %d", resp.StatusCode)
+	}
+
+	return nil
+}
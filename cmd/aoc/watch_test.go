@@ -0,0 +1,147 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pimvanhespen/advent-of-code/pkg/aoc"
+)
+
+func leaderboard(stars ...int) *aoc.Leaderboard {
+	m := aoc.Member{Id: 7, Name: "alice", CompletionDayLevel: make(map[int]aoc.DayLevel)}
+	for i, ts := range stars {
+		day := i/2 + 1
+		level := m.CompletionDayLevel[day]
+		if i%2 == 0 {
+			level.Part1 = &aoc.Part{GetStarTs: ts}
+		} else {
+			level.Part2 = &aoc.Part{GetStarTs: ts}
+		}
+		m.CompletionDayLevel[day] = level
+	}
+
+	return &aoc.Leaderboard{Event: "2023", Members: map[string]aoc.Member{"7": m}}
+}
+
+func TestWatcher_Poll(t *testing.T) {
+	var payloads []map[string]string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var p map[string]string
+		if err := json.NewDecoder(r.Body).Decode(&p); err != nil {
+			t.Errorf("decode payload: %v", err)
+		}
+		payloads = append(payloads, p)
+	}))
+	defer srv.Close()
+
+	hook, err := NewWebhookSink(srv.Client(), srv.URL, "slack")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var out, jsonl bytes.Buffer
+
+	boards := []*aoc.Leaderboard{
+		leaderboard(1701406860),
+		leaderboard(1701406860, 1701407100, 1701493500),
+		leaderboard(1701406860, 1701407100, 1701493500),
+	}
+
+	w := &Watcher{
+		Fetch: func(context.Context) (*aoc.Leaderboard, error) {
+			lb := boards[0]
+			boards = boards[1:]
+			return lb, nil
+		},
+		State: filepath.Join(t.TempDir(), "snapshot.json"),
+		Sinks: []Sink{WriterSink{Out: &out}, JSONLinesSink{Out: &jsonl}, hook},
+	}
+
+	for i := 0; i < 3; i++ {
+		if err = w.Poll(context.Background()); err != nil {
+			t.Fatalf("Poll() #%d error = %v", i, err)
+		}
+	}
+
+	want := "alice got star 2 on day 1\nalice got star 1 on day 2"
+	if len(payloads) != 1 || payloads[0]["text"] != want {
+		t.Errorf("webhook payloads = %v, want one with text %q", payloads, want)
+	}
+
+	if n := bytes.Count(jsonl.Bytes(), []byte("\n")); n != 2 {
+		t.Errorf("json lines = %d, want 2", n)
+	}
+
+	if n := bytes.Count(out.Bytes(), []byte("\n")); n != 2 {
+		t.Errorf("output lines = %d, want 2:\n%s", n, out.String())
+	}
+}
+
+func TestWatcher_Poll_interval(t *testing.T) {
+	var fetches int
+	w := &Watcher{
+		Fetch: func(context.Context) (*aoc.Leaderboard, error) {
+			fetches++
+			return leaderboard(1701406860), nil
+		},
+		State:    filepath.Join(t.TempDir(), "snapshot.json"),
+		Interval: time.Hour,
+	}
+
+	for i := 0; i < 2; i++ {
+		if err := w.Poll(context.Background()); err != nil {
+			t.Fatalf("Poll() #%d error = %v", i, err)
+		}
+	}
+
+	if fetches != 1 {
+		t.Errorf("fetches = %d, want 1 within the interval", fetches)
+	}
+}
+
+type failingSink struct{}
+
+func (failingSink) Send(context.Context, []aoc.StarEvent) error {
+	return errors.New("unreachable")
+}
+
+func TestWatcher_Poll_failingSink(t *testing.T) {
+	var out bytes.Buffer
+
+	boards := []*aoc.Leaderboard{
+		leaderboard(1701406860),
+		leaderboard(1701406860, 1701407100),
+		leaderboard(1701406860, 1701407100),
+	}
+
+	w := &Watcher{
+		Fetch: func(context.Context) (*aoc.Leaderboard, error) {
+			lb := boards[0]
+			boards = boards[1:]
+			return lb, nil
+		},
+		State: filepath.Join(t.TempDir(), "snapshot.json"),
+		Sinks: []Sink{failingSink{}, WriterSink{Out: &out}},
+	}
+
+	errs := 0
+	for i := 0; i < 3; i++ {
+		if err := w.Poll(context.Background()); err != nil {
+			errs++
+		}
+	}
+
+	if errs != 1 {
+		t.Errorf("failed polls = %d, want 1", errs)
+	}
+	if n := bytes.Count(out.Bytes(), []byte("\n")); n != 1 {
+		t.Errorf("output lines = %d, want 1 despite the failing sink:\n%s", n, out.String())
+	}
+}
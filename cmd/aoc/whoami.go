@@ -0,0 +1,35 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"time"
+
+	"github.com/pimvanhespen/advent-of-code/pkg/aoc"
+)
+
+func whoamiCommand(args []string) error {
+	var account string
+
+	fs := flag.NewFlagSet("whoami", flag.ExitOnError)
+	fs.StringVar(&account, "account", "", "account in config.toml to check")
+	_ = fs.Parse(args)
+
+	client, err := aoc.NewAccountClient(account)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	user, err := client.Whoami(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, _ = fmt.Println(user)
+
+	return nil
+}
@@ -1,19 +1,22 @@
 package main
 
 import (
+	"context"
 	"embed"
 	"flag"
 	"fmt"
-	"html/template"
 	"io"
 	"log"
 	"os"
 	"path/filepath"
 	"strconv"
 	"strings"
+	"text/template"
 	"time"
 
 	_ "embed"
+
+	"github.com/pimvanhespen/advent-of-code/pkg/aoc"
 )
 
 //go:embed templates
@@ -24,6 +27,13 @@ type Config struct {
 	Day    uint
 	DryRun bool
 	Force  bool
+	Puzzle bool
+
+	// Account is the account to download the puzzle with.
+	Account string
+
+	// Example is the first example of the puzzle description, if any.
+	Example string
 }
 
 func (c Config) IsValid() bool {
@@ -39,6 +49,8 @@ func main() {
 	flag.UintVar(&c.Day, "day", 0, "day of the event (1-25)")
 	flag.BoolVar(&c.DryRun, "dry-run", false, "do not write to disk")
 	flag.BoolVar(&c.Force, "force", false, "overwrite existing file")
+	flag.StringVar(&c.Account, "account", "", "account in config.toml to use")
+	flag.BoolVar(&c.Puzzle, "puzzle", true, "download the puzzle description and seed the example input")
 	flag.Parse()
 
 	if !c.IsValid() {
@@ -69,9 +81,17 @@ type Target struct {
 
 func (p *Preparer) Run() error {
 
+	if p.cfg.Puzzle {
+		if err := p.fetchPuzzle(); err != nil {
+			// the templates are still useful without the puzzle
+			log.Println("skipping puzzle:", err)
+		}
+	}
+
 	targets := []Target{
 		{Filename: "main.go.tmpl"},
 		{Filename: "main_test.go.tmpl"},
+		{Filename: "bench_test.go.tmpl"},
 	}
 
 	for _, t := range targets {
@@ -80,12 +100,53 @@ func (p *Preparer) Run() error {
 		}
 	}
 
+	if !p.cfg.DryRun {
+		log.Println("run `go generate ./cmd/aoc` to register the new day")
+	}
+
 	return nil
 }
 
+func (p *Preparer) fetchPuzzle() error {
+	c, err := aoc.NewAccountClient(p.cfg.Account)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	puzzle, err := c.DownloadPuzzle(ctx, int(p.cfg.Year), int(p.cfg.Day))
+	if err != nil {
+		return fmt.Errorf("download: %w", err)
+	}
+
+	if len(puzzle.Examples) > 0 {
+		p.cfg.Example = puzzle.Examples[0]
+	}
+
+	if p.cfg.DryRun {
+		return nil
+	}
+
+	return aoc.NewChallenge(int(p.cfg.Year), int(p.cfg.Day)).StorePuzzle(puzzle)
+}
+
+var funcs = template.FuncMap{
+	"literal": literal,
+}
+
+// literal returns s as a Go string literal, preferring a raw string.
+func literal(s string) string {
+	if strings.Contains(s, "`") {
+		return strconv.Quote(s)
+	}
+	return "`" + s + "`"
+}
+
 func (p *Preparer) prepare(t Target) error {
 
-	tpl, err := template.New(t.Filename).ParseFS(templates, filepath.Join("templates", t.Filename))
+	tpl, err := template.New(t.Filename).Funcs(funcs).ParseFS(templates, filepath.Join("templates", t.Filename))
 	if err != nil {
 		return fmt.Errorf("parsing template: %w", err)
 	}
@@ -107,15 +168,18 @@ func (p *Preparer) prepare(t Target) error {
 func (p *Preparer) getWriteCloser(filename string) (io.WriteCloser, error) {
 
 	if p.cfg.DryRun {
-		return os.Stdout, nil
+		return nopCloser{os.Stdout}, nil
 	}
 
-	root := "."
+	root, err := aoc.Root()
+	if err != nil {
+		return nil, err
+	}
 
 	// check if file exists
 	fp := filepath.Join(root, "events", strconv.Itoa(int(p.cfg.Year)), fmt.Sprintf("%02d", p.cfg.Day), filename)
 
-	_, err := os.Stat(fp)
+	_, err = os.Stat(fp)
 	switch {
 	case err == nil:
 		if !p.cfg.Force {
@@ -142,3 +206,11 @@ func (p *Preparer) getWriteCloser(filename string) (io.WriteCloser, error) {
 
 	return f, nil
 }
+
+type nopCloser struct {
+	io.Writer
+}
+
+func (nopCloser) Close() error {
+	return nil
+}
@@ -1,27 +1,11 @@
-package main
+package day01
 
 import (
-	"fmt"
-	"io"
-	"log"
-
 	"github.com/pimvanhespen/advent-of-code/pkg/aoc"
 )
 
-func main() {
-	input, err := aoc.NewChallenge(2015, 1).Input()
-	if err != nil {
-		log.Fatal(err)
-	}
-
-	b, err := io.ReadAll(input)
-	if err != nil {
-		log.Fatal(err)
-	}
-
-	fmt.Println("Part 1:", solve1(b))
-	fmt.Println("Part 2:", solve2(b))
-
+func init() {
+	aoc.Register(2015, 1, aoc.ReadAll, aoc.Answer(solve1), aoc.Answer(solve2))
 }
 
 func solve1(input []byte) int {
@@ -1,10 +1,8 @@
-package main
+package day02
 
 import (
 	"bytes"
-	"fmt"
 	"io"
-	"log"
 	"slices"
 	"strconv"
 	"strings"
@@ -12,23 +10,8 @@ import (
 	"github.com/pimvanhespen/advent-of-code/pkg/aoc"
 )
 
-func main() {
-
-	r, err := aoc.NewChallenge(2015, 2).Input()
-	if err != nil {
-		panic(err)
-	}
-
-	boxes, err := parse(r)
-	if err != nil {
-		log.Fatal(err)
-	}
-
-	one := solve1(boxes)
-	fmt.Println("one:", one)
-
-	two := solve2(boxes)
-	fmt.Println("two:", two)
+func init() {
+	aoc.Register(2015, 2, parse, aoc.Answer(solve1), aoc.Answer(solve2))
 }
 
 func solve1(boxes []Box) int {
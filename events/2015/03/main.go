@@ -1,28 +1,12 @@
-package main
+package day03
 
 import (
 	"github.com/pimvanhespen/advent-of-code/pkg/aoc"
 	"io"
-	"log"
 )
 
-func main() {
-
-	r, err := aoc.NewChallenge(2015, 3).Input()
-	if err != nil {
-		log.Fatal(err)
-	}
-
-	directions, err := parse(r)
-	if err != nil {
-		log.Fatal(err)
-	}
-
-	one := solve1(directions)
-	log.Println("one:", one)
-
-	two := solve2(directions)
-	log.Println("two:", two)
+func init() {
+	aoc.Register(2015, 3, parse, aoc.Answer(solve1), aoc.Answer(solve2))
 }
 
 func parse(reader io.Reader) ([]Direction, error) {
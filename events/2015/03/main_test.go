@@ -1,4 +1,4 @@
-package main
+package day03
 
 import (
 	"strings"
@@ -0,0 +1,20 @@
+package day04
+
+import (
+	"testing"
+
+	"github.com/pimvanhespen/advent-of-code/pkg/aoc"
+	"github.com/pimvanhespen/advent-of-code/pkg/aoc/aoctest"
+)
+
+func BenchmarkParse(b *testing.B) {
+	aoctest.BenchmarkParse(b, 2015, 4, parse)
+}
+
+func BenchmarkPart1(b *testing.B) {
+	aoctest.BenchmarkSolveContext(b, 2015, 4, parse, aoc.TypedContext(solve1))
+}
+
+func BenchmarkPart2(b *testing.B) {
+	aoctest.BenchmarkSolveContext(b, 2015, 4, parse, aoc.TypedContext(solve2))
+}
@@ -1,26 +1,33 @@
-package main
+package day04
 
 import (
 	"bytes"
+	"context"
 	"crypto/md5"
 	"encoding/hex"
-	"fmt"
+	"io"
 	"strconv"
+
+	"github.com/pimvanhespen/advent-of-code/pkg/aoc"
 )
 
-const input = `ckczppom`
+func init() {
+	aoc.RegisterContext(2015, 4, parse, aoc.TypedContext(solve1), aoc.TypedContext(solve2))
+}
 
-func main() {
-	fmt.Println("Part 1:", solve1(input))
-	fmt.Println("Part 2:", solve2(input))
+// parse reads the secret key.
+func parse(r io.Reader) (string, error) {
+	b, err := aoc.ReadAll(r)
+	return string(b), err
 }
 
-func find(input, zeroes string) int {
+// batch is the number of hashes between progress reports and context checks.
+const batch = 1 << 16
 
-	//f, _ := os.Create("cpu.prof")
-	//_ = pprof.StartCPUProfile(f)
-	//defer pprof.StopCPUProfile()
-	// go tool pprof -pdf cpu.prof > cpu.pdf && open cpu.pdf
+// find returns the lowest number that gives a hash starting with zeroes. The
+// number of hashes is not known up front, the progress counts the hashes tried.
+func find(ctx context.Context, input, zeroes string) (int, error) {
+	progress := aoc.Progress(ctx)
 
 	buff := bytes.NewBuffer(make([]byte, 0, len(input)+10))
 	buff.Write([]byte(input))
@@ -30,6 +37,13 @@ func find(input, zeroes string) int {
 	enc := make([]byte, 32)
 
 	for i := 0; ; i++ {
+		if i%batch == 0 && i > 0 {
+			if ctx.Err() != nil {
+				return 0, ctx.Err()
+			}
+			progress.Add(batch)
+		}
+
 		buff.Truncate(len(input))
 		buff.Write([]byte(strconv.Itoa(i)))
 
@@ -38,15 +52,15 @@ func find(input, zeroes string) int {
 		hex.Encode(enc, sum[:])
 
 		if string(enc[:len(zeroes)]) == zeroes {
-			return i
+			return i, nil
 		}
 	}
 }
 
-func solve1(input string) int {
-	return find(input, `00000`)
+func solve1(ctx context.Context, input string) (int, error) {
+	return find(ctx, input, `00000`)
 }
 
-func solve2(input string) int {
-	return find(input, `000000`)
+func solve2(ctx context.Context, input string) (int, error) {
+	return find(ctx, input, `000000`)
 }
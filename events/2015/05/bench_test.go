@@ -0,0 +1,20 @@
+package day05
+
+import (
+	"testing"
+
+	"github.com/pimvanhespen/advent-of-code/pkg/aoc"
+	"github.com/pimvanhespen/advent-of-code/pkg/aoc/aoctest"
+)
+
+func BenchmarkParse(b *testing.B) {
+	aoctest.BenchmarkParse(b, 2015, 5, parse)
+}
+
+func BenchmarkPart1(b *testing.B) {
+	aoctest.BenchmarkSolve(b, 2015, 5, parse, aoc.Answer(solve1))
+}
+
+func BenchmarkPart2(b *testing.B) {
+	aoctest.BenchmarkSolve(b, 2015, 5, parse, aoc.Answer(solve2))
+}
@@ -1,10 +1,8 @@
-package main
+package day05
 
 import (
-	"fmt"
 	"github.com/pimvanhespen/advent-of-code/pkg/aoc"
 	"io"
-	"log"
 	"regexp"
 	"strings"
 )
@@ -14,21 +12,8 @@ var (
 	vowels = regexp.MustCompile(`a|e|i|o|u`)
 )
 
-func main() {
-
-	input, err := aoc.NewChallenge(2015, 5).Input()
-	if err != nil {
-		log.Fatal(err)
-	}
-
-	strings, err := parse(input)
-	if err != nil {
-		log.Fatal(err)
-	}
-
-	fmt.Println("Part 1:", solve1(strings))
-	fmt.Println("Part 2:", solve2(strings))
-
+func init() {
+	aoc.Register(2015, 5, parse, aoc.Answer(solve1), aoc.Answer(solve2))
 }
 
 func parse(input io.Reader) ([]string, error) {
@@ -1,10 +1,11 @@
-package main
+package day06
 
 import (
 	"fmt"
 	"github.com/pimvanhespen/advent-of-code/pkg/aoc"
+	"github.com/pimvanhespen/advent-of-code/pkg/geom"
+	"github.com/pimvanhespen/advent-of-code/pkg/grid"
 	"io"
-	"log"
 	"regexp"
 	"strconv"
 	"strings"
@@ -24,48 +25,25 @@ const (
 	ToggleOperation
 )
 
-type Point struct {
-	X, Y int
-}
+type Point = geom.Vec2[int]
 
-type Grid[T any] [1000][1000]T
+// size is the width and height of the grid of lights.
+const size = 1000
 
-func (g *Grid[T]) Apply(r Range, fun func(T) T) {
-	for x := r.From.X; x <= r.To.X; x++ {
-		for y := r.From.Y; y <= r.To.Y; y++ {
-			g[x][y] = fun(g[x][y])
+func apply[T any](g grid.Grid[T], r Range, fun func(T) T) {
+	for x := r.Min.X; x <= r.Max.X; x++ {
+		for y := r.Min.Y; y <= r.Max.Y; y++ {
+			p := Point{X: x, Y: y}
+			g.Set(p, fun(g.At(p)))
 		}
 	}
 }
 
-func (g *Grid[T]) Count(valuer func(T) int) int {
-	var count int
-	for _, row := range g {
-		for _, cell := range row {
-			count += valuer(cell)
-		}
-	}
-	return count
+func init() {
+	aoc.Register(2015, 6, parse, aoc.Answer(solve1), aoc.Answer(solve2))
 }
 
-func main() {
-	input, err := aoc.NewChallenge(2015, 6).Input()
-	if err != nil {
-		log.Fatal(err)
-	}
-
-	commands, err := parse(input)
-	if err != nil {
-		log.Fatal(err)
-	}
-
-	fmt.Println("Part 1:", solve1(commands))
-	fmt.Println("Part 2:", solve2(commands))
-}
-
-type Range struct {
-	From, To Point
-}
+type Range = geom.Rect[int]
 
 func TurnOn(_ bool) bool {
 	return true
@@ -80,24 +58,21 @@ func Toggle(b bool) bool {
 }
 
 func solve1(commands []Command) int {
-	var grid Grid[bool]
+	g := grid.New[bool](size, size)
 
 	for _, cmd := range commands {
 		switch cmd.Op {
 		case OnOperation:
-			grid.Apply(cmd.Range, TurnOn)
+			apply(g, cmd.Range, TurnOn)
 		case OffOperation:
-			grid.Apply(cmd.Range, TurnOff)
+			apply(g, cmd.Range, TurnOff)
 		case ToggleOperation:
-			grid.Apply(cmd.Range, Toggle)
+			apply(g, cmd.Range, Toggle)
 		}
 	}
 
-	return grid.Count(func(b bool) int {
-		if b {
-			return 1
-		}
-		return 0
+	return g.Count(func(b bool) bool {
+		return b
 	})
 }
 
@@ -109,22 +84,24 @@ func max(a, b int) int {
 }
 
 func solve2(commands []Command) int {
-	var grid Grid[int]
+	g := grid.New[int](size, size)
 
 	for _, cmd := range commands {
 		switch cmd.Op {
 		case OnOperation:
-			grid.Apply(cmd.Range, func(i int) int { return i + 1 })
+			apply(g, cmd.Range, func(i int) int { return i + 1 })
 		case OffOperation:
-			grid.Apply(cmd.Range, func(i int) int { return max(0, i-1) })
+			apply(g, cmd.Range, func(i int) int { return max(0, i-1) })
 		case ToggleOperation:
-			grid.Apply(cmd.Range, func(i int) int { return i + 2 })
+			apply(g, cmd.Range, func(i int) int { return i + 2 })
 		}
 	}
 
-	return grid.Count(func(i int) int {
-		return i
+	var brightness int
+	g.Each(func(_ Point, i int) {
+		brightness += i
 	})
+	return brightness
 }
 
 var cmdre = regexp.MustCompile(`(turn on|turn off|toggle) (\d+),(\d+) through (\d+),(\d+)`)
@@ -178,7 +155,7 @@ func parse(input io.Reader) ([]Command, error) {
 
 		commands[i] = Command{
 			Op:    op,
-			Range: Range{_range[0], _range[1]},
+			Range: geom.NewRect(_range[0], _range[1]),
 		}
 	}
 
@@ -1,10 +1,10 @@
-package main
+package day07
 
 import (
+	"errors"
 	"fmt"
 	"github.com/pimvanhespen/advent-of-code/pkg/aoc"
 	"io"
-	"log"
 	"maps"
 	"strconv"
 	"strings"
@@ -66,22 +66,8 @@ type Input struct {
 	Wires map[string]*Wire
 }
 
-func main() {
-	input, err := aoc.NewChallenge(2015, 7).Input()
-	if err != nil {
-		log.Fatal(err)
-	}
-
-	m, err := parse(input)
-	if err != nil {
-		log.Fatal(err)
-	}
-
-	one := solve1(m)
-	log.Println("Part one:", one)
-
-	two := solve2(m)
-	log.Println("Part two:", two)
+func init() {
+	aoc.RegisterContext(2015, 7, parse, aoc.Typed(solve1), aoc.Typed(solve2))
 }
 
 func parse(reader io.Reader) (Input, error) {
@@ -143,45 +129,48 @@ func split(formula string, op Operation) (Operation, []string, error) {
 	return op, parts, nil
 }
 
-func solve1(m Input) uint16 {
+func solve1(m Input) (uint16, error) {
 
 	wires := maps.Clone(m.Wires)
 
 	a, ok := wires["a"]
 	if !ok {
-		log.Fatal("wire a not found")
+		return 0, errors.New("wire a not found")
 	}
 
 	results, err := eval(wires)
 	if err != nil {
-		log.Fatal(err)
+		return 0, err
 	}
 
-	return results[a.Name]
+	return results[a.Name], nil
 }
 
-func solve2(i Input) uint16 {
+func solve2(i Input) (uint16, error) {
 
 	wires := maps.Clone(i.Wires)
 
 	results, err := eval(wires)
 	if err != nil {
-		log.Fatal(err)
+		return 0, err
 	}
 
 	b := results["a"]
 
 	wires = maps.Clone(i.Wires)
 
-	wires["b"].Operation = Set
-	wires["b"].Sources = []string{strconv.FormatUint(uint64(b), 10)}
+	// override a copy, the wires are shared with the input
+	override := *wires["b"]
+	override.Operation = Set
+	override.Sources = []string{strconv.FormatUint(uint64(b), 10)}
+	wires["b"] = &override
 
 	results, err = eval(wires)
 	if err != nil {
-		log.Fatal(err)
+		return 0, err
 	}
 
-	return results["a"]
+	return results["a"], nil
 }
 
 func isNumber(s string) bool {
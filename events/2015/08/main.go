@@ -1,26 +1,14 @@
-package main
+package day08
 
 import (
+	"github.com/pimvanhespen/advent-of-code/events/2015/08/xstring"
 	"github.com/pimvanhespen/advent-of-code/pkg/aoc"
-	"github.com/pimvanhespen/aoc/2015/days/08/xstring"
 	"io"
-	"log"
 	"strings"
 )
 
-func main() {
-	r, err := aoc.NewChallenge(2015, 8).Input()
-	if err != nil {
-		log.Fatal(err)
-	}
-
-	lines, err := parse(r)
-	if err != nil {
-		log.Fatal(err)
-	}
-
-	log.Println("Part 1:", part1(lines))
-	log.Println("Part 2:", part2(lines))
+func init() {
+	aoc.RegisterContext(2015, 8, parse, aoc.Typed(part1), aoc.Answer(part2).Context())
 }
 
 func parse(r io.Reader) ([]string, error) {
@@ -32,7 +20,7 @@ func parse(r io.Reader) ([]string, error) {
 	return strings.Split(string(b), "\n"), nil
 }
 
-func part1(lines []string) int {
+func part1(lines []string) (int, error) {
 
 	var base int
 	for _, line := range lines {
@@ -47,14 +35,14 @@ func part1(lines []string) int {
 
 		self, err := xstring.Unquote(line)
 		if err != nil {
-			log.Fatal(err)
+			return 0, err
 		}
 
 		ln := len([]rune(self))
 		total += ln
 	}
 
-	return base - total
+	return base - total, nil
 }
 
 func part2(lines []string) int {
@@ -1,7 +1,7 @@
-package main
+package day08
 
 import (
-	"github.com/pimvanhespen/aoc/2015/days/08/xstring"
+	"github.com/pimvanhespen/advent-of-code/events/2015/08/xstring"
 	"testing"
 )
 
@@ -12,7 +12,10 @@ func Test_part1(t *testing.T) {
 		`"aaa\"aaa"`,
 		`"\x27"`,
 	}
-	n := part1(sss)
+	n, err := part1(sss)
+	if err != nil {
+		t.Fatal(err)
+	}
 
 	if n != 12 {
 		t.Errorf("part1() = %v, want %v", n, 12)
@@ -1,27 +1,15 @@
-package main
+package day09
 
 import (
 	"bytes"
 	"fmt"
 	"github.com/pimvanhespen/advent-of-code/pkg/aoc"
 	"io"
-	"log"
 	"math"
 )
 
-func main() {
-	r, err := aoc.NewChallenge(2015, 9).Input()
-	if err != nil {
-		log.Fatal(err)
-	}
-
-	input, err := parse(r)
-	if err != nil {
-		log.Fatal(err)
-	}
-
-	log.Println("Part 1:", part1(input))
-	log.Println("Part 2:", part2(input))
+func init() {
+	aoc.Register(2015, 9, parse, aoc.Answer(part1), aoc.Answer(part2))
 }
 
 type City string
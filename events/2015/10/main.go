@@ -1,9 +1,10 @@
-package main
+package day10
 
 import (
-	"fmt"
+	"io"
 	"strings"
-	"time"
+
+	"github.com/pimvanhespen/advent-of-code/pkg/aoc"
 )
 
 type Sequence = string
@@ -35,20 +36,27 @@ func lookAndSay(s string) string {
 	return sb.String()
 }
 
-const input = `1113222113`
+func init() {
+	aoc.Register(2015, 10, parse, aoc.Answer(part1), aoc.Answer(part2))
+}
 
-func main() {
-	t1 := time.Now()
-	sequence := Sequence(input)
-	for i := 0; i < 40; i++ {
-		sequence = lookAndSay(sequence)
-	}
-	fmt.Println(len(sequence))
+func parse(r io.Reader) (Sequence, error) {
+	b, err := aoc.ReadAll(r)
+	return Sequence(b), err
+}
+
+func part1(s Sequence) int {
+	return len(repeat(s, 40))
+}
+
+func part2(s Sequence) int {
+	return len(repeat(s, 50))
+}
 
-	for i := 0; i < 10; i++ {
-		sequence = lookAndSay(sequence)
+// repeat returns s after n rounds of look-and-say.
+func repeat(s Sequence, n int) Sequence {
+	for i := 0; i < n; i++ {
+		s = lookAndSay(s)
 	}
-	fmt.Println(len(sequence))
-	d1 := time.Now().Sub(t1)
-	fmt.Println(d1)
+	return s
 }
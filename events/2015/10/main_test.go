@@ -1 +1 @@
-package main
+package day10
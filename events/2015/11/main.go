@@ -1,12 +1,27 @@
-package main
+package day11
 
-import "strings"
+import (
+	"io"
+	"strings"
 
-const input = "cqjxjnds"
+	"github.com/pimvanhespen/advent-of-code/pkg/aoc"
+)
 
-func main() {
-	println("Part 1:", next(input))
-	println("Part 2:", next(next(input)))
+func init() {
+	aoc.Register(2015, 11, parse, part1, part2)
+}
+
+func parse(r io.Reader) (string, error) {
+	b, err := aoc.ReadAll(r)
+	return string(b), err
+}
+
+func part1(password string) string {
+	return next(password)
+}
+
+func part2(password string) string {
+	return next(next(password))
 }
 
 func next(input string) string {
@@ -1,4 +1,4 @@
-package main
+package day12
 
 import (
 	"encoding/json"
@@ -9,19 +9,13 @@ import (
 	"strconv"
 )
 
-func main() {
-	r, err := aoc.NewChallenge(2015, 12).Input()
-	if err != nil {
-		panic(err)
-	}
-
-	b, err := io.ReadAll(r)
-	if err != nil {
-		panic(err)
-	}
+func init() {
+	aoc.Register(2015, 12, parse, aoc.Answer(part1), aoc.Answer(part2))
+}
 
-	fmt.Println("Part 1:", part1(string(b)))
-	fmt.Println("Part 2:", part2(string(b)))
+func parse(r io.Reader) (string, error) {
+	b, err := aoc.ReadAll(r)
+	return string(b), err
 }
 
 var nums = regexp.MustCompile(`-?\d+`)
@@ -84,8 +78,6 @@ func count(v any) int {
 		fmt.Printf("Unknown type %T\n", v)
 		return 0
 	}
-
-	return 0
 }
 
 func isRed(v any) bool {
@@ -1,9 +1,10 @@
-package main
+package day13
 
 import (
 	"fmt"
 	"github.com/pimvanhespen/advent-of-code/pkg/aoc"
 	"io"
+	"maps"
 	"strconv"
 	"strings"
 )
@@ -12,29 +13,22 @@ type Person = string
 
 type DirectedScores map[Person]map[Person]int
 
-func main() {
-
-	r, err := aoc.NewChallenge(2015, 13).Input()
-	if err != nil {
-		panic(err)
-	}
-
-	input, err := parseInput(r)
-	if err != nil {
-		panic(err)
-	}
-
-	fmt.Println("Part 1:", part1(input))
+func init() {
+	aoc.Register(2015, 13, parseInput, aoc.Answer(part1), aoc.Answer(part2))
+}
 
+// part2 seats me as well, with a happiness of 0 next to anyone.
+func part2(in DirectedScores) int {
 	const me Person = "me"
 
-	input[me] = make(map[Person]int)
-	for p := range input {
-		input[p][me] = 0
-		input[me][p] = 0
+	scores := DirectedScores{me: make(map[Person]int)}
+	for p, others := range in {
+		scores[p] = maps.Clone(others)
+		scores[p][me] = 0
+		scores[me][p] = 0
 	}
 
-	fmt.Println("Part 2:", part1(input))
+	return part1(scores)
 }
 
 func part1(in DirectedScores) int {
@@ -1,111 +1,72 @@
-package main
+package day14
 
 import (
 	"fmt"
 	"github.com/pimvanhespen/advent-of-code/pkg/aoc"
 	"io"
 	"slices"
-	"strconv"
-	"strings"
 )
 
 type Reindeer struct {
-	name     string
-	speed    int
-	flyTime  int
-	restTime int
-	lap      int
+	Name  string
+	Speed int // km/s
+	Fly   int // seconds
+	Rest  int // seconds
 }
 
+var reindeerPattern = aoc.Scanf[Reindeer]("{Name} can fly {Speed} km/s for {Fly} seconds, but then must rest for {Rest} seconds.")
+
 func (r Reindeer) String() string {
-	return fmt.Sprintf("%s: speed %d km/s, fly %d seconds, rest %d seconds.", r.name, r.speed, r.flyTime, r.restTime)
+	return fmt.Sprintf("%s: speed %d km/s, fly %d seconds, rest %d seconds.", r.Name, r.Speed, r.Fly, r.Rest)
+}
+
+func (r Reindeer) lap() int {
+	return r.Fly + r.Rest
 }
 
 func (r Reindeer) Distance(raceTime int) int {
 
 	var total int
 	// How many full cycles
-	fullCycles := raceTime / r.lap
-	total += fullCycles * r.speed * r.flyTime
+	fullCycles := raceTime / r.lap()
+	total += fullCycles * r.Speed * r.Fly
 
 	// How many seconds in the last lap
-	lastCycle := raceTime % r.lap
-	if lastCycle > r.flyTime {
-		lastCycle = r.flyTime
+	lastCycle := raceTime % r.lap()
+	if lastCycle > r.Fly {
+		lastCycle = r.Fly
 	}
-	total += lastCycle * r.speed
+	total += lastCycle * r.Speed
 	return total
 }
 
 func (r Reindeer) SpeedOn(time int) int {
-	if time%r.lap < r.flyTime {
-		return r.speed
+	if time%r.lap() < r.Fly {
+		return r.Speed
 	}
 	return 0
 }
 
-func main() {
-	reader, err := aoc.NewChallenge(2015, 14).Input()
-	if err != nil {
-		panic(err)
-	}
-
-	reindeers, err := parse(reader)
-	if err != nil {
-		panic(err)
-	}
+// raceTime is the length of the race in seconds.
+const raceTime = 2503
 
-	fmt.Println("Part 1:", part1(reindeers, 2503))
-	fmt.Println("Part 2:", part2(reindeers, 2503))
+func init() {
+	aoc.Register(2015, 14, parse, aoc.Answer(part1), aoc.Answer(part2))
 }
 
-func parse(reader io.Reader) ([]Reindeer, error) {
-
-	b, err := io.ReadAll(reader)
-	if err != nil {
-		return nil, fmt.Errorf("could not read input: %w", err)
-	}
-
-	s := strings.TrimSpace(string(b))
-	s = strings.NewReplacer(" can fly ", ",", " km/s for ", ",", " seconds, but then must rest for ", ",", " seconds.", "").Replace(s)
-
-	reindeers := make([]Reindeer, 0)
-
-	// Rudolph can fly 22 km/s for 8 seconds, but then must rest for 165 seconds.
-	for _, line := range strings.Split(s, "\n") {
-		if line == "" {
-			continue
-		}
-
-		parts := strings.Split(line, ",")
-
-		name := parts[0]
-		speed, err := strconv.Atoi(parts[1])
-		if err != nil {
-			return nil, fmt.Errorf("could not parse speed: %w", err)
-		}
-		flyTime, err := strconv.Atoi(parts[2])
-		if err != nil {
-			return nil, fmt.Errorf("could not parse flyTime: %w", err)
-		}
-		restTime, err := strconv.Atoi(parts[3])
-		if err != nil {
-			return nil, fmt.Errorf("could not parse restTime: %w", err)
-		}
+func part1(reindeers []Reindeer) int {
+	return farthest(reindeers, raceTime)
+}
 
-		reindeers = append(reindeers, Reindeer{
-			name:     name,
-			speed:    speed,
-			flyTime:  flyTime,
-			restTime: restTime,
-			lap:      flyTime + restTime,
-		})
-	}
+func part2(reindeers []Reindeer) int {
+	return mostPoints(reindeers, raceTime)
+}
 
-	return reindeers, nil
+func parse(reader io.Reader) ([]Reindeer, error) {
+	return reindeerPattern.Lines(reader)
 }
 
-func part1(reindeers []Reindeer, until int) int {
+func farthest(reindeers []Reindeer, until int) int {
 	var far int
 	for _, r := range reindeers {
 		far = max(far, r.Distance(until))
@@ -114,7 +75,7 @@ func part1(reindeers []Reindeer, until int) int {
 }
 
 // brute force, cuz it's fast enough (and 1am)
-func part2(reindeers []Reindeer, until int) int {
+func mostPoints(reindeers []Reindeer, until int) int {
 
 	scores := make([]int, len(reindeers))
 	position := make([]int, len(reindeers))
@@ -1,14 +1,12 @@
-package main
+package day15
 
 import (
-	"bytes"
-	"fmt"
 	"github.com/pimvanhespen/advent-of-code/pkg/aoc"
 	"io"
-	"strconv"
 )
 
 type Ingredient struct {
+	Name       string
 	Capacity   int
 	Durability int
 	Flavor     int
@@ -48,25 +46,19 @@ func Calories(ingredients []Ingredient, fracts Fractions) int {
 	return total
 }
 
-func main() {
-	reader, err := aoc.NewChallenge(2015, 15).Input()
-	if err != nil {
-		panic(err)
-	}
-
-	ingredients, err := parseIngredients(reader)
-	if err != nil {
-		panic(err)
-	}
-
-	fracts := getOptimalRecipe(ingredients)
+func init() {
+	aoc.Register(2015, 15, parseIngredients, aoc.Answer(part1), aoc.Answer(part2))
+}
 
-	fmt.Println("Part 1:", Score(ingredients, fracts))
+func part1(ingredients []Ingredient) int {
+	return Score(ingredients, getOptimalRecipe(ingredients))
+}
 
-	fracts2 := getOptimalRecipe(ingredients, func(ingredients []Ingredient, fracts Fractions) bool {
+func part2(ingredients []Ingredient) int {
+	fracts := getOptimalRecipe(ingredients, func(ingredients []Ingredient, fracts Fractions) bool {
 		return Calories(ingredients, fracts) == 500
 	})
-	fmt.Println("Part 2:", Score(ingredients, fracts2))
+	return Score(ingredients, fracts)
 }
 
 type KeepFunc func([]Ingredient, Fractions) bool
@@ -115,57 +107,8 @@ func permute(n, total int) []Fractions {
 	return result
 }
 
-func parseIngredients(reader io.Reader) ([]Ingredient, error) {
-
-	b, err := io.ReadAll(reader)
-	if err != nil {
-		return nil, fmt.Errorf("could not read input: %w", err)
-	}
-
-	b = bytes.TrimSuffix(b, []byte("\n"))
-	lines := bytes.Split(b, []byte("\n"))
-
-	ingredients := make([]Ingredient, len(lines))
-	for i, l := range lines {
-		fields := bytes.Fields(l)
-
-		//name := bytes.TrimSuffix(fields[0], []byte(":"))
-
-		cs := string(bytes.TrimSuffix(fields[2], []byte(",")))
-		capacity, err := strconv.Atoi(cs)
-		if err != nil {
-			return nil, fmt.Errorf("could not parse capacity: %q: %w", cs, err)
-		}
+var ingredientPattern = aoc.Scanf[Ingredient]("{Name}: capacity {Capacity}, durability {Durability}, flavor {Flavor}, texture {Texture}, calories {Calories}")
 
-		durability, err := strconv.Atoi(string(bytes.TrimSuffix(fields[4], []byte(","))))
-		if err != nil {
-			return nil, fmt.Errorf("could not parse durability: %w", err)
-		}
-
-		flavor, err := strconv.Atoi(string(bytes.TrimSuffix(fields[6], []byte(","))))
-		if err != nil {
-			return nil, fmt.Errorf("could not parse flavor: %w", err)
-		}
-
-		texture, err := strconv.Atoi(string(bytes.TrimSuffix(fields[8], []byte(","))))
-		if err != nil {
-			return nil, fmt.Errorf("could not parse texture: %w", err)
-		}
-
-		calories, err := strconv.Atoi(string(bytes.TrimSuffix(fields[10], []byte(","))))
-		if err != nil {
-			return nil, fmt.Errorf("could not parse calories: %w", err)
-		}
-
-		ingredients[i] = Ingredient{
-			Capacity:   capacity,
-			Durability: durability,
-			Flavor:     flavor,
-			Texture:    texture,
-			Calories:   calories,
-		}
-
-	}
-
-	return ingredients, nil
+func parseIngredients(reader io.Reader) ([]Ingredient, error) {
+	return ingredientPattern.Lines(reader)
 }
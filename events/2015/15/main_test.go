@@ -1,4 +1,4 @@
-package main
+package day15
 
 import (
 	"strings"
@@ -31,18 +31,15 @@ Cinnamon: capacity 2, durability 3, flavor -2, texture -1, calories 3
 	}
 }
 
-func TestRecipe_Score(t *testing.T) {
-	recipe := Recipe{
-		Ingredients: []Ingredient{
-			{Name: "Butterscotch", Capacity: -1, Durability: -2, Flavor: 6, Texture: 3, Calories: 8},
-			{Name: "Cinnamon", Capacity: 2, Durability: 3, Flavor: -2, Texture: -1, Calories: 3},
-		},
-		Fractions: []uint8{44, 56},
+func TestScore(t *testing.T) {
+	ingredients := []Ingredient{
+		{Name: "Butterscotch", Capacity: -1, Durability: -2, Flavor: 6, Texture: 3, Calories: 8},
+		{Name: "Cinnamon", Capacity: 2, Durability: 3, Flavor: -2, Texture: -1, Calories: 3},
 	}
 
 	const expect = 62842880
 
-	got := recipe.Score()
+	got := Score(ingredients, Fractions{44, 56})
 
 	if got != expect {
 		t.Errorf("expected score to be %d, got %d", expect, got)
@@ -1,4 +1,4 @@
-package main
+package day16
 
 import (
 	"bytes"
@@ -35,30 +35,22 @@ trees: 3
 cars: 2
 perfumes: 1`
 
-func main() {
-	reader, err := aoc.NewChallenge(2015, 16).Input()
-	if err != nil {
-		panic(err)
-	}
-
-	got, err := parseTickerTape([]byte(ticker), []byte("\n"))
-	if err != nil {
-		panic(err)
-	}
+// tape is what the MFCSAM detected.
+var tape = aoc.Must(parseTickerTape([]byte(ticker), []byte("\n")))
 
-	aunts, err := parseAunts(reader)
-	if err != nil {
-		panic(err)
-	}
+func init() {
+	aoc.Register(2015, 16, parseAunts, aoc.Answer(part1), aoc.Answer(part2))
+}
 
-	res1 := part1(got, aunts)
-	fmt.Println("1:", res1)
+func part1(aunts []Aunt) int {
+	return findAunt(tape, aunts)
+}
 
-	res2 := part2(got, aunts)
-	fmt.Println("2:", res2)
+func part2(aunts []Aunt) int {
+	return findRealAunt(tape, aunts)
 }
 
-func part1(got TickerTape, aunts []Aunt) int {
+func findAunt(got TickerTape, aunts []Aunt) int {
 	keep := make([]int, 0, len(aunts))
 	for _, aunt := range aunts {
 		if !got.ContainsAll(aunt.Values) {
@@ -87,7 +79,7 @@ func correctAmount(key string, got, want int) bool {
 	}
 }
 
-func part2(got TickerTape, aunts []Aunt) int {
+func findRealAunt(got TickerTape, aunts []Aunt) int {
 
 	keep := make([]int, 0, len(aunts))
 outer:
@@ -1,29 +1,28 @@
-package main
+package day17
 
 import (
-	"fmt"
 	"github.com/pimvanhespen/advent-of-code/pkg/aoc"
+	"io"
 	"strconv"
 )
 
-func main() {
-	reader, err := aoc.NewChallenge(2015, 17).Input()
-	if err != nil {
-		panic(err)
-	}
+// liters is the amount of eggnog to store.
+const liters = 150
 
-	containers, err := aoc.ParseLines(reader, strconv.Atoi)
-	if err != nil {
-		panic(err)
-	}
+func init() {
+	aoc.Register(2015, 17, parse, aoc.Answer(part1), aoc.Answer(part2))
+}
 
-	perms := permutations(containers, 150)
+func parse(r io.Reader) ([]int, error) {
+	return aoc.ParseLines(r, strconv.Atoi)
+}
 
-	// Part 1
-	fmt.Println("Part 1:", len(perms))
+func part1(containers []int) int {
+	return len(permutations(containers, liters))
+}
 
-	// Part 2
-	fmt.Println("Part 2:", least(perms))
+func part2(containers []int) int {
+	return least(permutations(containers, liters))
 }
 
 func least(perms [][]int) int {
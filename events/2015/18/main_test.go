@@ -1,11 +1,10 @@
-package main
+package day18
 
 import (
-	"bytes"
-	"fmt"
-	"os"
 	"strings"
 	"testing"
+
+	"github.com/pimvanhespen/advent-of-code/pkg/grid"
 )
 
 func TestParse(t *testing.T) {
@@ -23,16 +22,11 @@ func TestParse(t *testing.T) {
 		t.Error(err)
 	}
 
-	if len(board.Cells) != 36 {
-		t.Errorf("Expected 36 cells, got %d", len(board.Cells))
-	}
-
-	writer := bytes.Buffer{}
-	if err = board.Print(&writer); err != nil {
-		t.Error(err)
+	if w, h := board.Size(); w*h != 36 {
+		t.Errorf("Expected 36 cells, got %d", w*h)
 	}
 
-	res := writer.String()
+	res := board.String()
 	if res != input {
 		t.Errorf("Expected %q, got %q", input, res)
 	}
@@ -80,22 +74,12 @@ func TestNext(t *testing.T) {
 		t.Error(err)
 	}
 
-	next := NewBoard(board.X, board.Y)
+	next := grid.New[bool](board.Size())
 
 	for _, sample := range nextSamples {
 		Next(board, next)
 
-		writer := bytes.Buffer{}
-		if err = next.Print(&writer); err != nil {
-			t.Error(err)
-		}
-
-		fmt.Println("NEXT")
-		_ = next.Print(os.Stdout)
-		fmt.Println("EXPECTED")
-		fmt.Println(sample)
-
-		res := writer.String()
+		res := next.String()
 		res = strings.TrimSpace(res)
 		if res != sample {
 			t.Errorf("Expected %q, got %q", sample, res)
@@ -119,20 +103,15 @@ func TestBoard_Neighbours(t *testing.T) {
 		t.Error(err)
 	}
 
-	neighbours := make([][]int, board.Y)
-	for y := 0; y < board.Y; y++ {
-		line := make([]int, board.X)
-		for x := 0; x < board.X; x++ {
-			line[x] = board.Neighbours(x, y)
+	neighbours := make([][]int, board.Height())
+	for y := range neighbours {
+		line := make([]int, board.Width())
+		for x := range line {
+			line[x] = Neighbours(board, grid.Point{X: x, Y: y})
 		}
 		neighbours[y] = line
 	}
 
-	fmt.Println("NEIGHBOURS")
-	for _, line := range neighbours {
-		fmt.Println(line)
-	}
-
 	expected := [][]int{
 		{1, 0, 3, 2, 4, 1},
 		{2, 2, 3, 2, 4, 3},
@@ -142,11 +121,6 @@ func TestBoard_Neighbours(t *testing.T) {
 		{2, 4, 3, 2, 2, 1},
 	}
 
-	fmt.Println("EXPECTED")
-	for _, line := range expected {
-		fmt.Println(line)
-	}
-
 	for y, expect := range expected {
 		got := neighbours[y]
 
@@ -207,24 +181,12 @@ func TestNext2(t *testing.T) {
 		t.Fail()
 	}
 
-	next := NewBoard(begin.X, begin.Y)
+	next := grid.New[bool](begin.Size())
 
 	for _, sample := range next2Samples[1:] {
 		Next2(begin, next)
 
-		writer := bytes.Buffer{}
-		if err = next.Print(&writer); err != nil {
-			t.Error(err)
-		}
-
-		fmt.Println()
-		fmt.Println("NEXT")
-		_ = next.Print(os.Stdout)
-
-		fmt.Println("EXPECTED")
-		fmt.Println(sample)
-
-		res := writer.String()
+		res := next.String()
 		res = strings.TrimSpace(res)
 		if res != sample {
 			t.Errorf("Expected %q, got %q", sample, res)
@@ -249,17 +211,15 @@ func TestNext22(t *testing.T) {
 		t.Error(err)
 	}
 
-	next := NewBoard(board.X, board.Y)
+	next := grid.New[bool](board.Size())
 
 	for i := 0; i < 5; i++ {
 		Next2(board, next)
 		board, next = next, board
 	}
 
-	res := board.Count()
+	res := board.Count(isOn)
 	if res != 17 {
 		t.Errorf("Expected 17, got %d", res)
 	}
-
-	_ = board.Print(os.Stdout)
 }
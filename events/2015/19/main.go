@@ -1,12 +1,11 @@
-package main
+package day19
 
 import (
-	"bufio"
-	"fmt"
+	"context"
 	"github.com/pimvanhespen/advent-of-code/pkg/aoc"
 	"github.com/pimvanhespen/advent-of-code/pkg/datastructures/heap"
 	"io"
-	"log"
+	"log/slog"
 	"math"
 	"strings"
 	"sync"
@@ -18,45 +17,34 @@ type Data struct {
 	molecule     string
 }
 
-func main() {
-	reader, err := aoc.NewChallenge(2015, 19).Input()
-	if err != nil {
-		panic(err)
-	}
-
-	data, err := parse(reader)
-	if err != nil {
-		panic(err)
-	}
+func init() {
+	aoc.RegisterContext(2015, 19, parse, aoc.Answer(solve1).Context(), aoc.TypedContext(solve2))
+}
 
-	fmt.Println("Part 1:", solve1(data))
-	fmt.Println("Part 2:", solve2(data))
+type Replacement struct {
+	From, To string
 }
 
+var parser = aoc.Sections(
+	aoc.Lines(aoc.Scanf[Replacement]("{From} => {To}").Parse),
+	aoc.Line(func(s string) (string, error) { return s, nil }),
+)
+
 func parse(reader io.Reader) (Data, error) {
+	p, err := parser(reader)
+	if err != nil {
+		return Data{}, err
+	}
+
 	data := Data{
 		replacements: make(map[string][]string),
+		molecule:     p.Second,
 	}
-
-	scanner := bufio.NewScanner(reader)
-	for scanner.Scan() {
-		if err := scanner.Err(); err != nil {
-			return Data{}, err
-		}
-
-		text := scanner.Text()
-		if text == "" {
-			break
-		}
-		parts := strings.Split(scanner.Text(), " => ")
-
-		data.replacements[parts[0]] = append(data.replacements[parts[0]], parts[1])
+	for _, r := range p.First {
+		data.replacements[r.From] = append(data.replacements[r.From], r.To)
 	}
 
-	scanner.Scan()
-	data.molecule = scanner.Text()
-
-	return data, scanner.Err()
+	return data, nil
 }
 
 // todo: learn how to solve this
@@ -84,7 +72,8 @@ func solve1(data Data) int {
 	return len(seen)
 }
 
-func solve2(data Data) int {
+func solve2(ctx context.Context, data Data) (int, error) {
+	log := aoc.Logger(ctx)
 
 	type state struct {
 		molecule string
@@ -141,7 +130,7 @@ func solve2(data Data) int {
 		go func() {
 			defer func() {
 				wg.Done()
-				log.Println("worker done")
+				log.Debug("Worker done")
 			}()
 			for task := range tasks {
 				if task.steps > least.steps {
@@ -176,11 +165,11 @@ func solve2(data Data) int {
 	}()
 
 	for s := range reduced {
-		log.Println(s)
+		log.Info("Reduced", slog.String("Molecule", s.molecule), slog.Int("Steps", s.steps))
 		if s.steps < least.steps {
 			least = s
 		}
 	}
 
-	return least.steps
+	return least.steps, nil
 }
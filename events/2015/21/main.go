@@ -1,6 +1,11 @@
-package main
+package day21
 
-import "sort"
+import (
+	"slices"
+	"sort"
+
+	"github.com/pimvanhespen/advent-of-code/pkg/aoc"
+)
 
 // Day 21: RPG Simulator 20XX
 
@@ -43,9 +48,15 @@ type Character struct {
 	Armor int
 }
 
-func main() {
-	boss := Character{103, 9, 2}
-	player := Character{100, 0, 0}
+var bossPattern = aoc.Scanf[Character]("Hit Points: {HP}\nDamage: {DMG}\nArmor: {Armor}")
+
+func init() {
+	aoc.Register(2015, 21, bossPattern.Input, aoc.Answer(part1), aoc.Answer(part2))
+}
+
+// part1 returns the least gold to spend and still win.
+func part1(boss Character) int {
+	player := Character{HP: 100}
 
 	least := 1000
 
@@ -79,10 +90,17 @@ func main() {
 		}
 	}
 
-	println(least)
+	return least
+}
+
+// part2 returns the most gold to spend and still lose.
+func part2(boss Character) int {
+	player := Character{HP: 100}
 
 	most := 0
 
+	// the most expensive items first, sorted copies as parts share the items
+	weapons, armors, rings := slices.Clone(weapons), slices.Clone(armors), slices.Clone(rings)
 	sortMost(rings)
 	sortMost(weapons)
 	sortMost(armors)
@@ -117,7 +135,7 @@ func main() {
 		}
 	}
 
-	println(most)
+	return most
 }
 
 func sortLeast(items []Item) {
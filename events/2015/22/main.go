@@ -1,35 +1,28 @@
-package main
+package day22
 
 import (
-	"fmt"
-	"github.com/pimvanhespen/aoc/2015/days/22/wizsim"
+	"github.com/pimvanhespen/advent-of-code/events/2015/22/wizsim"
+	"github.com/pimvanhespen/advent-of-code/pkg/aoc"
 	"math"
 )
 
-func main() {
-
-	// enable for debug output
-	wizsim.Logging = false
-
-	player := wizsim.Player{
-		Health: 50,
-		Mana:   500,
-	}
+var (
+	player      = wizsim.Player{Health: 50, Mana: 500}
+	bossPattern = aoc.Scanf[wizsim.Boss]("Hit Points: {Health}\nDamage: {Damage}")
+)
 
-	boss := wizsim.Boss{
-		Health: 55,
-		Damage: 8,
-	}
+func init() {
+	aoc.Register(2015, 22, bossPattern.Input, aoc.Answer(part1), aoc.Answer(part2))
+}
 
+func part1(boss wizsim.Boss) int {
 	g := wizsim.NewGame(player, boss)
+	return solve1(g, wizsim.Shield, wizsim.Poison, wizsim.MagicMissile, wizsim.Recharge)
+}
 
-	one := solve1(g, wizsim.Shield, wizsim.Poison, wizsim.MagicMissile, wizsim.Recharge)
-	fmt.Printf("1): %d\n", one)
-
-	g = wizsim.NewGame(player, boss)
-
-	two := solve2(g, wizsim.MagicMissile, wizsim.Drain, wizsim.Shield, wizsim.Poison, wizsim.Recharge)
-	fmt.Printf("2): %d\n", two)
+func part2(boss wizsim.Boss) int {
+	g := wizsim.NewGame(player, boss)
+	return solve2(g, wizsim.MagicMissile, wizsim.Drain, wizsim.Shield, wizsim.Poison, wizsim.Recharge)
 }
 
 func solve1(initial *wizsim.Game, spells ...*wizsim.Spell) int {
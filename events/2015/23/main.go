@@ -1,4 +1,4 @@
-package main
+package day23
 
 import (
 	"fmt"
@@ -25,20 +25,8 @@ type Input struct {
 	Program []Instruction
 }
 
-func main() {
-
-	reader, err := aoc.NewChallenge(2015, 23).Input()
-	if err != nil {
-		panic(err)
-	}
-
-	input, err := parse(reader)
-	if err != nil {
-		panic(err)
-	}
-
-	fmt.Println("Part 1:", part1(input))
-	fmt.Println("Part 2:", part2(input))
+func init() {
+	aoc.Register(2015, 23, parse, aoc.Answer(part1), aoc.Answer(part2))
 }
 
 func parse(reader io.Reader) (Input, error) {
@@ -1,11 +1,10 @@
-package main
+package day24
 
 import (
 	"fmt"
 	"github.com/pimvanhespen/advent-of-code/pkg/aoc"
 	"io"
 	"math"
-	"os"
 	"slices"
 	"strconv"
 )
@@ -14,13 +13,8 @@ type Input struct {
 	Weights []int
 }
 
-func main() {
-
-	aoc.New[Input](24, os.Stdout,
-		aoc.NewParser(parse),
-		aoc.NewSolver(part1),
-		aoc.NewSolver(part2),
-	)
+func init() {
+	aoc.Register(2015, 24, parse, part1, part2)
 }
 
 func parse(reader io.Reader) (Input, error) {
@@ -1,10 +1,9 @@
-package main
+package day25
 
 import (
 	"fmt"
 	"github.com/pimvanhespen/advent-of-code/pkg/aoc"
 	"io"
-	"os"
 )
 
 type Input struct {
@@ -12,12 +11,8 @@ type Input struct {
 	Start    uint
 }
 
-func main() {
-	aoc.New[Input](25, os.Stdout,
-		aoc.NewParser(parse),
-		aoc.NewSolver(solve1),
-		aoc.NewSolver(solve2),
-	)
+func init() {
+	aoc.Register(2015, 25, parse, solve1, solve2)
 }
 
 func parse(reader io.Reader) (Input, error) {
@@ -1,4 +1,4 @@
-package main
+package day01
 
 import (
 	"bytes"
@@ -7,6 +7,7 @@ import (
 	"strconv"
 
 	"github.com/pimvanhespen/advent-of-code/pkg/aoc"
+	"github.com/pimvanhespen/advent-of-code/pkg/geom"
 )
 
 type Input struct {
@@ -18,105 +19,43 @@ type Step struct {
 	Distance int
 }
 
-type Vector2 struct {
-	X, Y int
+func init() {
+	aoc.Register(2016, 1, parse, part1, part2)
 }
 
-func (v Vector2) Add(other Vector2) Vector2 {
-	return Vector2{
-		X: v.X + other.X,
-		Y: v.Y + other.Y,
+func turn(direction geom.Dir, step Step) geom.Dir {
+	if step.IsLeft {
+		return direction.TurnLeft()
 	}
-}
-
-func (v Vector2) Mul(scalar int) Vector2 {
-	return Vector2{
-		X: v.X * scalar,
-		Y: v.Y * scalar,
-	}
-}
-
-func (v Vector2) Rotate(degrees int) Vector2 {
-	if degrees%90 != 0 {
-		panic("rotation must be a multiple of 90 degrees")
-	}
-
-	degrees = (degrees + 360) % 360
-	switch degrees {
-	case 0:
-		return v
-	case 90:
-		return Vector2{
-			X: v.Y,
-			Y: -v.X,
-		}
-	case 180:
-		return Vector2{
-			X: -v.X,
-			Y: -v.Y,
-		}
-	case 270:
-		return Vector2{
-			X: -v.Y,
-			Y: v.X,
-		}
-	default:
-		panic(fmt.Sprintf("invalid rotation: %d", degrees))
-	}
-}
-
-func Manhattan(a, b Vector2) int {
-	return abs(a.X-b.X) + abs(a.Y-b.Y)
-}
-
-func abs(i int) int {
-	if i < 0 {
-		return -i
-	}
-	return i
-}
-
-func main() {
-	event := aoc.New(2016, 1, parse)
-	fmt.Println("1:", aoc.Must(event.Run(part1)))
-	fmt.Println("2:", aoc.Must(event.Run(part2)))
+	return direction.TurnRight()
 }
 
 func part1(i Input) string {
-	position := Vector2{}
-	direction := Vector2{X: 0, Y: 1} // north
+	var position geom.Vec2[int]
+	direction := geom.North
 
 	for _, step := range i.Steps {
-		if step.IsLeft {
-			direction = direction.Rotate(90)
-		} else {
-			direction = direction.Rotate(-90)
-		}
-
-		position = position.Add(direction.Mul(step.Distance))
+		direction = turn(direction, step)
+		position = position.Move(direction, step.Distance)
 	}
 
-	return fmt.Sprint(Manhattan(Vector2{}, position))
+	return fmt.Sprint(position.Manhattan())
 }
 
 func part2(i Input) string {
-	var position Vector2
-	direction := Vector2{X: 0, Y: 1} // north
+	var position geom.Vec2[int]
+	direction := geom.North
 
-	cache := make(map[Vector2]bool)
+	cache := make(map[geom.Vec2[int]]bool)
 	cache[position] = true
 
 	for _, step := range i.Steps {
-		if step.IsLeft {
-			direction = direction.Rotate(90)
-		} else {
-			direction = direction.Rotate(-90)
-		}
+		direction = turn(direction, step)
 
 		for i := 0; i < step.Distance; i++ {
-			position = position.Add(direction)
+			position = position.Move(direction, 1)
 			if cache[position] {
-				return fmt.Sprint(Manhattan(Vector2{}, position))
+				return fmt.Sprint(position.Manhattan())
 			} else {
 				cache[position] = true
 			}
@@ -1,20 +1,18 @@
-package main
+package day02
 
 import (
-	"fmt"
 	"io"
 
 	"github.com/pimvanhespen/advent-of-code/pkg/aoc"
+	"github.com/pimvanhespen/advent-of-code/pkg/geom"
 )
 
 type Input []Instruction
 
 type Instruction []byte
 
-func main() {
-	event := aoc.New(2016, 2, parse)
-	fmt.Println("1:", aoc.Must(event.Run(part1)))
-	fmt.Println("2:", aoc.Must(event.Run(part2)))
+func init() {
+	aoc.Register(2016, 2, parse, part1, part2)
 }
 
 func part1(input Input) string {
@@ -42,27 +40,18 @@ func part1(input Input) string {
 	return string(code)
 }
 
-type Vector2 struct {
-	X, Y int
-}
-
 func part2(input Input) string {
 	code := make([]byte, len(input))
 	for i, line := range input {
 
-		var pos Vector2
+		var pos geom.Vec2[int]
 
 		for _, c := range line {
-			switch c {
-			case 'U':
-				pos = move(pos, Vector2{0, 1})
-			case 'D':
-				pos = move(pos, Vector2{0, -1})
-			case 'L':
-				pos = move(pos, Vector2{-1, 0})
-			case 'R':
-				pos = move(pos, Vector2{1, 0})
+			dir, err := geom.ParseDir(string(c))
+			if err != nil {
+				continue
 			}
+			pos = move(pos, dir)
 		}
 
 		code[i] = digit(pos)
@@ -71,61 +60,44 @@ func part2(input Input) string {
 	return string(code)
 }
 
-func move(pos, dir Vector2) Vector2 {
-	newPos := Vector2{
-		X: pos.X + dir.X,
-		Y: pos.Y + dir.Y,
-	}
+func move(pos geom.Vec2[int], dir geom.Dir) geom.Vec2[int] {
+	newPos := pos.Move(dir, 1)
 
-	if !newPos.valid() {
+	// the keypad is a diamond around 7
+	if newPos.Manhattan() > 2 {
 		return pos
 	}
 
 	return newPos
 }
 
-func (v Vector2) valid() bool {
-	return Manhattan(v, Vector2{0, 0}) <= 2
-}
-
-func Manhattan(a, b Vector2) int {
-	return abs(a.X-b.X) + abs(a.Y-b.Y)
-}
-
-func abs(i int) int {
-	if i < 0 {
-		return -i
-	}
-	return i
-}
-
-func digit(pos Vector2) byte {
+func digit(pos geom.Vec2[int]) byte {
 	switch pos {
-	case Vector2{0, 2}:
+	case geom.V2(0, -2):
 		return '1'
-	case Vector2{-1, 1}:
+	case geom.V2(-1, -1):
 		return '2'
-	case Vector2{0, 1}:
+	case geom.V2(0, -1):
 		return '3'
-	case Vector2{1, 1}:
+	case geom.V2(1, -1):
 		return '4'
-	case Vector2{-2, 0}:
+	case geom.V2(-2, 0):
 		return '5'
-	case Vector2{-1, 0}:
+	case geom.V2(-1, 0):
 		return '6'
-	case Vector2{0, 0}:
+	case geom.V2(0, 0):
 		return '7'
-	case Vector2{1, 0}:
+	case geom.V2(1, 0):
 		return '8'
-	case Vector2{2, 0}:
+	case geom.V2(2, 0):
 		return '9'
-	case Vector2{-1, -1}:
+	case geom.V2(-1, 1):
 		return 'A'
-	case Vector2{0, -1}:
+	case geom.V2(0, 1):
 		return 'B'
-	case Vector2{1, -1}:
+	case geom.V2(1, 1):
 		return 'C'
-	case Vector2{0, -2}:
+	case geom.V2(0, 2):
 		return 'D'
 	}
 
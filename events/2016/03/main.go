@@ -1,4 +1,4 @@
-package main
+package day03
 
 import (
 	"fmt"
@@ -19,10 +19,8 @@ func (t Triangle) Valid() bool {
 		t.B+t.C > t.A
 }
 
-func main() {
-	event := aoc.New(2016, 3, parse)
-	fmt.Println("1:", aoc.Must(event.Run(part1)))
-	fmt.Println("2:", aoc.Must(event.Run(part2)))
+func init() {
+	aoc.Register(2016, 3, parse, part1, part2)
 }
 
 func parse(r io.Reader) (Input, error) {
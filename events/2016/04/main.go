@@ -1,4 +1,4 @@
-package main
+package day04
 
 import (
 	"fmt"
@@ -47,10 +47,8 @@ func shift(c byte, n int) byte {
 	return c
 }
 
-func main() {
-	event := aoc.New(2016, 4, parse)
-	fmt.Println("1:", aoc.Must(event.Run(part1)))
-	fmt.Println("2:", aoc.Must(event.Run(part2)))
+func init() {
+	aoc.Register(2016, 4, parse, part1, part2)
 }
 
 func parse(r io.Reader) (Input, error) {
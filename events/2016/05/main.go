@@ -1,22 +1,20 @@
-package main
+package day05
 
 import (
 	"bytes"
+	"context"
 	"crypto/md5"
 	"encoding/hex"
-	"fmt"
 	"github.com/pimvanhespen/advent-of-code/pkg/aoc"
 	"io"
-	"log"
+	"log/slog"
 	"strconv"
 )
 
 type Input = []byte
 
-func main() {
-	event := aoc.New(2016, 5, parse)
-	fmt.Println("1:", aoc.Must(event.Run(part1)))
-	fmt.Println("2:", aoc.Must(event.Run(part2)))
+func init() {
+	aoc.RegisterContext(2016, 5, parse, part1, part2)
 }
 
 func parse(r io.Reader) (Input, error) {
@@ -29,15 +27,23 @@ func parse(r io.Reader) (Input, error) {
 	return b, nil
 }
 
-func part1(input Input) string {
+func part1(ctx context.Context, input Input) (string, error) {
 	var password [8]byte
 	var index int
 
+	log := aoc.Logger(ctx)
+	progress := aoc.Progress(ctx)
+	progress.SetTotal(len(password))
+
 	hash := md5.New()
 	data := make([]byte, 64)
 
 	var offset int
 	for ; ; index++ {
+		if index%(1<<16) == 0 && ctx.Err() != nil {
+			return "", ctx.Err()
+		}
+
 		n := number(index)
 		hash.Reset()
 		hash.Write(input)
@@ -46,32 +52,41 @@ func part1(input Input) string {
 
 		if data[0] == 48 && data[1] == 48 && data[2] == 48 && data[3] == 48 && data[4] == 48 {
 			password[offset] = data[5]
-			log.Printf("Character %c found at %d (%s)", data[5], index, string(password[:]))
+			log.Info("Character found", slog.String("Char", string(data[5])), slog.Int("Index", index), slog.String("Password", string(password[:])))
 
 			offset++
+			progress.Add(1)
 			if offset == len(password) {
 				break
 			}
 		}
 	}
 
-	return string(password[:])
+	return string(password[:]), nil
 }
 
 func number(n int) []byte {
 	return []byte(strconv.Itoa(n))
 }
 
-func part2(input Input) string {
+func part2(ctx context.Context, input Input) (string, error) {
 	var password [8]byte
 	var mask [8]bool
 	var index int
 
+	log := aoc.Logger(ctx)
+	progress := aoc.Progress(ctx)
+	progress.SetTotal(len(password))
+
 	hash := md5.New()
 	data := make([]byte, 64)
 
 	var offset int
 	for ; ; index++ {
+		if index%(1<<16) == 0 && ctx.Err() != nil {
+			return "", ctx.Err()
+		}
+
 		n := number(index)
 		hash.Reset()
 		hash.Write(input)
@@ -83,12 +98,12 @@ func part2(input Input) string {
 			pos := data[5] - '0'
 
 			if int(pos) >= len(mask) {
-				log.Printf("Skipping %d", pos)
+				log.Debug("Skipping", slog.Int("Position", int(pos)))
 				continue
 			}
 
 			if mask[pos] {
-				log.Printf("Skipping %d; already set", pos)
+				log.Debug("Skipping; already set", slog.Int("Position", int(pos)))
 				continue
 			}
 
@@ -96,14 +111,15 @@ func part2(input Input) string {
 			ch := data[6]
 			password[pos] = ch
 
-			log.Printf("Character %c found at %d (%s)", ch, index, string(password[:]))
+			log.Info("Character found", slog.String("Char", string(ch)), slog.Int("Index", index), slog.String("Password", string(password[:])))
 
 			offset++
+			progress.Add(1)
 			if offset == len(password) {
 				break
 			}
 		}
 	}
 
-	return string(password[:])
+	return string(password[:]), nil
 }
@@ -1,6 +1,9 @@
-package main
+package day05
 
-import "testing"
+import (
+	"context"
+	"testing"
+)
 
 func Test_part1(t *testing.T) {
 	type args struct {
@@ -21,7 +24,11 @@ func Test_part1(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := part1(tt.args.input); got != tt.want {
+			got, err := part1(context.Background(), tt.args.input)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
 				t.Errorf("part1() = %v, want %v", got, tt.want)
 			}
 		})
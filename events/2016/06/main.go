@@ -1,7 +1,6 @@
-package main
+package day06
 
 import (
-	"fmt"
 	"io"
 	"math"
 
@@ -12,10 +11,8 @@ type Input [][]byte
 
 type Alphabet [26]uint16
 
-func main() {
-	event := aoc.New(2016, 6, parse)
-	fmt.Println("1:", aoc.Must(event.Run(part1)))
-	fmt.Println("2:", aoc.Must(event.Run(part2)))
+func init() {
+	aoc.Register(2016, 6, parse, part1, part2)
 }
 
 func parse(r io.Reader) (Input, error) {
@@ -1,4 +1,4 @@
-package main
+package day07
 
 import (
 	"bytes"
@@ -44,10 +44,8 @@ func abba(sub []byte) bool {
 	return sub[0] == sub[3] && sub[1] == sub[2] && sub[0] != sub[1]
 }
 
-func main() {
-	event := aoc.New(2016, 7, parse)
-	fmt.Println("1:", aoc.Must(event.Run(part1)))
-	fmt.Println("2:", aoc.Must(event.Run(part2)))
+func init() {
+	aoc.Register(2016, 7, parse, part1, part2)
 }
 
 func parse(r io.Reader) (Input, error) {
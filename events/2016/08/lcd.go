@@ -1,100 +1,61 @@
-package main
+package day08
 
 import (
 	"fmt"
-	"strings"
+
+	"github.com/pimvanhespen/advent-of-code/pkg/grid"
 )
 
 type Display struct {
-	segments      [][]bool
-	width, height int
+	grid.Grid[bool]
 }
 
 func NewDisplay(width, height int) *Display {
-	rows := make([][]bool, height)
-	for i := range rows {
-		rows[i] = make([]bool, width)
-	}
-
-	return &Display{
-		segments: rows,
-		width:    width,
-		height:   height,
-	}
+	return &Display{Grid: grid.New[bool](width, height)}
 }
 
-func (d *Display) String() string {
-	var sb strings.Builder
-	for _, row := range d.segments {
-		for _, col := range row {
-			if col {
-				sb.WriteByte('#')
-			} else {
-				sb.WriteByte(' ')
-			}
-		}
-		sb.WriteByte('\n')
-	}
-	return sb.String()
+// Lit implements aoc.Screen.
+func (d *Display) Lit(x, y int) bool {
+	return d.At(grid.Point{X: x, Y: y})
 }
 
 func (d *Display) Rect(width, height int) {
 	for y := 0; y < height; y++ {
 		for x := 0; x < width; x++ {
-			d.segments[y][x] = true
+			d.Set(grid.Point{X: x, Y: y}, true)
 		}
 	}
 }
 
 // ShiftRow shifts the yth row to the right by n pixels
 func (d *Display) ShiftRow(y int, n int) {
-	if y < 0 || y >= d.height {
+	if y < 0 || y >= d.Height() {
 		panic(fmt.Sprintf("y out of bounds: %d", y))
 	}
 
-	// normalize
-	n = (n + d.width) % d.width
-	if n == 0 {
-		return
-	}
-
-	offset := d.width - n
+	row := d.Row(y)
+	n = (n%len(row) + len(row)) % len(row)
 
-	d.segments[y] = append(d.segments[y][offset:], d.segments[y][:offset]...) // fyi. not working. offset is off
+	shifted := append([]bool(nil), row...)
+	for x := range row {
+		row[(x+n)%len(row)] = shifted[x]
+	}
 }
 
 // ShiftColumn shifts the xth column down by n pixels
 func (d *Display) ShiftColumn(x int, n int) {
-	if x < 0 || x >= d.width {
+	if x < 0 || x >= d.Width() {
 		panic(fmt.Sprintf("x out of bounds: %d", x))
 	}
 
-	n = (n + d.height) % d.height
-	if n == 0 {
-		return
-	}
+	col := d.Column(x)
+	n = (n%len(col) + len(col)) % len(col)
 
-	newCol := make([]bool, d.height)
-	for y := 0; y < d.height; y++ {
-		newCol[y] = d.segments[y][x]
-	}
-
-	offset := d.height - n
-	newCol = append(newCol[offset:], newCol[:offset]...)
-
-	for y := 0; y < d.height; y++ {
-		d.segments[y][x] = newCol[y]
+	for y := range col {
+		d.Set(grid.Point{X: x, Y: (y + n) % len(col)}, col[y])
 	}
 }
 
 func (d *Display) Count() int {
-	var count int
-	for _, row := range d.segments {
-		for _, col := range row {
-			if col {
-				count++
-			}
-		}
-	}
-	return count
+	return d.Grid.Count(func(lit bool) bool { return lit })
 }
@@ -1,4 +1,4 @@
-package main
+package day08
 
 import (
 	"fmt"
@@ -10,10 +10,8 @@ import (
 
 type Input []Instruction
 
-func main() {
-	event := aoc.New(2016, 8, parse)
-	fmt.Println("1:", aoc.Must(event.Run(part1)))
-	fmt.Println("2:", aoc.Must(event.Run(part2)))
+func init() {
+	aoc.RegisterContext(2016, 8, parse, aoc.Typed(part1), aoc.Typed(part2))
 }
 
 func parse(r io.Reader) (Input, error) {
@@ -48,19 +46,19 @@ func parseInstruction(s string) (Instruction, error) {
 	return nil, fmt.Errorf("unknown instruction: %s", s)
 }
 
-func part1(input Input) string {
-
+func run(input Input) *Display {
 	d := NewDisplay(50, 6)
 	for _, i := range input {
 		i.Apply(d)
 	}
-	return fmt.Sprint(d.Count())
+	return d
 }
 
-func part2(input Input) string {
-	d := NewDisplay(50, 6)
-	for _, i := range input {
-		i.Apply(d)
-	}
-	return fmt.Sprintf("\n%s\n", d.String())
+func part1(input Input) (int, error) {
+	return run(input).Count(), nil
+}
+
+// part2 returns the display, the runner reads its letters.
+func part2(input Input) (*Display, error) {
+	return run(input), nil
 }
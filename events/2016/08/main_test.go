@@ -1,4 +1,4 @@
-package main
+package day08
 
 import (
 	"fmt"
@@ -15,3 +15,18 @@ func TestStuff(t *testing.T) {
 	d.ShiftColumn(1, 2)
 	fmt.Println(d)
 }
+
+func TestDisplay(t *testing.T) {
+	d := NewDisplay(7, 3)
+	for _, i := range []Instruction{Rect{3, 2}, ShiftColumn{1, 1}, ShiftRow{0, 4}, ShiftColumn{1, 1}} {
+		i.Apply(d)
+	}
+
+	want := ".#..#.#\n#.#....\n.#.....\n"
+	if got := d.String(); got != want {
+		t.Errorf("Display = %q, want %q", got, want)
+	}
+	if got := d.Count(); got != 6 {
+		t.Errorf("Count() = %d, want 6", got)
+	}
+}
@@ -1,4 +1,4 @@
-package main
+package day09
 
 import (
 	"bytes"
@@ -11,10 +11,8 @@ import (
 
 type Input = []byte
 
-func main() {
-	event := aoc.New(2016, 9, io.ReadAll)
-	fmt.Println("1:", aoc.Must(event.Run(part1)))
-	fmt.Println("2:", aoc.Must(event.Run(part2)))
+func init() {
+	aoc.Register(2016, 9, io.ReadAll, part1, part2)
 }
 
 func part1(input Input) string {
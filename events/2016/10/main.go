@@ -1,4 +1,4 @@
-package main
+package day10
 
 import (
 	"fmt"
@@ -62,10 +62,8 @@ func (o *Output) Receive(value int) {
 	o.data = append(o.data, value)
 }
 
-func main() {
-	event := aoc.New(2016, 10, parse)
-	fmt.Println("1:", aoc.Must(event.Run(part1)))
-	fmt.Println("2:", aoc.Must(event.Run(part2)))
+func init() {
+	aoc.Register(2016, 10, parse, part1, part2)
 }
 
 type FauxReceiver struct {
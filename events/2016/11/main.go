@@ -1,14 +1,13 @@
-package main
+package day11
 
 import (
+	"context"
 	"fmt"
 	"github.com/pimvanhespen/advent-of-code/pkg/aoc"
 	"github.com/pimvanhespen/advent-of-code/pkg/datastructures/heap"
 	"io"
 	"math/bits"
-	"os"
 	"regexp"
-	"runtime/pprof"
 	"strings"
 )
 
@@ -17,10 +16,8 @@ type Input struct {
 	State    State
 }
 
-func main() {
-	event := aoc.New(2016, 11, parse)
-	fmt.Println("1:", aoc.Must(event.Run(part1)))
-	fmt.Println("2:", aoc.Must(event.Run(part2)))
+func init() {
+	aoc.RegisterContext(2016, 11, parse, part1, part2)
 }
 
 var (
@@ -76,18 +73,7 @@ type Route struct {
 	Steps uint8
 }
 
-func solve(initial State) int {
-
-	f, err := os.Create("cpu.pprof")
-	if err != nil {
-		panic(err)
-	}
-	defer f.Close()
-
-	if err = pprof.StartCPUProfile(f); err != nil {
-		panic(err)
-	}
-	defer pprof.StopCPUProfile()
+func solve(ctx context.Context, initial State) (int, error) {
 
 	// Goal is to move all components to the top floor
 	// We can only move 2 components at a time
@@ -99,7 +85,12 @@ func solve(initial State) int {
 	least := uint8(255)
 
 	// BFS
-	for !queue.Empty() {
+	for n := 0; !queue.Empty(); n++ {
+		// the search can take long, stop when the runner gives up
+		if n%(1<<12) == 0 && ctx.Err() != nil {
+			return 0, ctx.Err()
+		}
+
 		route := queue.Pop()
 
 		if route.Steps > least {
@@ -138,19 +129,25 @@ func solve(initial State) int {
 		}
 	}
 
-	return int(least)
+	return int(least), nil
 }
 
-func part1(input Input) string {
-	count := solve(input.State)
-	return aoc.Result(count)
+func part1(ctx context.Context, input Input) (string, error) {
+	count, err := solve(ctx, input.State)
+	if err != nil {
+		return "", err
+	}
+	return aoc.Result(count), nil
 }
 
-func part2(input Input) string {
+func part2(ctx context.Context, input Input) (string, error) {
 	s := input.State
 	s.Floors[0].Add(Components{3 << 5, 3 << 5})
-	count := solve(s)
-	return aoc.Result(count)
+	count, err := solve(ctx, s)
+	if err != nil {
+		return "", err
+	}
+	return aoc.Result(count), nil
 }
 
 func done(floors [4]Floor) bool {
@@ -1,6 +1,7 @@
-package main
+package day11
 
 import (
+	"context"
 	"reflect"
 	"sort"
 	"strings"
@@ -366,7 +367,12 @@ The fourth floor contains nothing relevant.`
 		t.Fatal(err)
 	}
 
-	if got := part1(floors); got != "11" {
+	got, err := part1(context.Background(), floors)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got != "11" {
 		t.Errorf("part1() = %v, want %v", got, "11")
 	}
 }
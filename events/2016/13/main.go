@@ -1,10 +1,11 @@
-package main
+package day13
 
 import (
 	"fmt"
 	"github.com/pimvanhespen/advent-of-code/pkg/aoc"
 	"github.com/pimvanhespen/advent-of-code/pkg/astar"
 	"github.com/pimvanhespen/advent-of-code/pkg/datastructures/heap"
+	"github.com/pimvanhespen/advent-of-code/pkg/geom"
 	"io"
 	"strconv"
 )
@@ -20,10 +21,8 @@ type Input struct {
 	Target      Vec2
 }
 
-func main() {
-	event := aoc.New(2016, 13, parse)
-	fmt.Println("1:", aoc.Must(event.Run(part1)))
-	fmt.Println("2:", aoc.Must(event.Run(part2)))
+func init() {
+	aoc.Register(2016, 13, parse, part1, part2)
 }
 
 func parse(reader io.Reader) (Input, error) {
@@ -39,7 +38,7 @@ func parse(reader io.Reader) (Input, error) {
 
 	return Input{
 		MagicNumber: n,
-		Target:      Vec2{31, 39},
+		Target:      Vec2{X: 31, Y: 39},
 	}, nil
 }
 
@@ -47,7 +46,7 @@ func part1(input Input) string {
 
 	m := NewMap(50, 50, isWall(input.MagicNumber))
 
-	path := m.ShortestPath(Vec2{1, 1}, input.Target)
+	path := m.ShortestPath(Vec2{X: 1, Y: 1}, input.Target)
 
 	return aoc.Result(len(path) - 1)
 }
@@ -56,30 +55,12 @@ func part2(input Input) string {
 
 	m := NewMap(50, 50, isWall(input.MagicNumber))
 
-	options := m.Endpoints(Vec2{1, 1}, 50)
+	options := m.Endpoints(Vec2{X: 1, Y: 1}, 50)
 
 	return aoc.Result(len(options))
 }
 
-type Vec2 struct {
-	X int
-	Y int
-}
-
-func (c Vec2) String() string {
-	return fmt.Sprintf("(%d,%d)", c.X, c.Y)
-}
-
-func ManhattanDistance(a, b Vec2) int {
-	return abs(a.X-b.X) + abs(a.Y-b.Y)
-}
-
-func abs(x int) int {
-	if x < 0 {
-		return -x
-	}
-	return x
-}
+type Vec2 = geom.Vec2[int]
 
 type Map struct {
 	Width  int
@@ -108,7 +89,7 @@ func (m *Map) Write(w io.Writer) (int, error) {
 
 func (m *Map) Neighbors(c Vec2) []Vec2 {
 	var neighbors []Vec2
-	for _, d := range []Vec2{{0, -1}, {0, 1}, {-1, 0}, {1, 0}} {
+	for _, d := range []Vec2{{X: 0, Y: -1}, {X: 0, Y: 1}, {X: -1, Y: 0}, {X: 1, Y: 0}} {
 		x := c.X + d.X
 		y := c.Y + d.Y
 		if x < 0 || x >= m.Width || y < 0 || y >= m.Height {
@@ -117,7 +98,7 @@ func (m *Map) Neighbors(c Vec2) []Vec2 {
 		if m.Data[y][x] {
 			continue
 		}
-		neighbors = append(neighbors, Vec2{x, y})
+		neighbors = append(neighbors, Vec2{X: x, Y: y})
 	}
 	return neighbors
 }
@@ -132,7 +113,7 @@ func (m *Map) ShortestPath(from, to Vec2) []Vec2 {
 			if m.Data[y][x] {
 				continue
 			}
-			c := Vec2{x, y}
+			c := Vec2{X: x, Y: y}
 			nodes[c] = astar.Node(&node{
 				coord:     c,
 				neighbors: make([]astar.Neighbor, 0),
@@ -156,7 +137,7 @@ func (m *Map) ShortestPath(from, to Vec2) []Vec2 {
 		nodes[from],
 		nodes[to],
 		func(a, b astar.Node) float64 {
-			return float64(ManhattanDistance(a.(*node).coord, b.(*node).coord))
+			return float64(geom.Manhattan(a.(*node).coord, b.(*node).coord))
 		},
 	)
 
@@ -183,7 +164,7 @@ func (m *Map) WritePath(stdout io.Writer, path []Vec2) (int, error) {
 			var c byte
 			if m.Data[y][x] {
 				c = Wall
-			} else if lookup[Vec2{x, y}] {
+			} else if lookup[Vec2{X: x, Y: y}] {
 				c = Path
 			} else {
 				c = Floor
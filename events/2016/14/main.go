@@ -1,7 +1,8 @@
-package main
+package day14
 
 import (
 	"bytes"
+	"context"
 	"crypto/md5"
 	"fmt"
 	"github.com/pimvanhespen/advent-of-code/pkg/aoc"
@@ -10,10 +11,8 @@ import (
 
 type Input = []byte
 
-func main() {
-	event := aoc.New(2016, 14, parse)
-	fmt.Println("1:", aoc.Must(event.Run(part1)))
-	fmt.Println("2:", aoc.Must(event.Run(part2)))
+func init() {
+	aoc.RegisterContext(2016, 14, parse, part1, part2)
 }
 
 func parse(r io.Reader) (Input, error) {
@@ -50,11 +49,13 @@ func hashSalt(salt []byte, index uint) string {
 	return fmt.Sprintf("%x", md5.Sum(data))
 }
 
-func part1(salt []byte) string {
-
-	keys := solve(salt, hashSalt)
+func part1(ctx context.Context, salt []byte) (string, error) {
+	keys, err := solve(ctx, salt, hashSalt)
+	if err != nil {
+		return "", err
+	}
 
-	return fmt.Sprint(keys[len(keys)-1].index)
+	return fmt.Sprint(keys[len(keys)-1].index), nil
 }
 
 type Hasher func(salt []byte, index uint) string
@@ -64,12 +65,21 @@ type key struct {
 	hash  string
 }
 
-func solve(salt []byte, fn Hasher) []key {
+const keyCount = 64
+
+func solve(ctx context.Context, salt []byte, fn Hasher) ([]key, error) {
 
 	var keys []key
 
+	progress := aoc.Progress(ctx)
+	progress.SetTotal(keyCount)
+
 	seen := make([]string, 1, 1001)
 	for index := uint(0); ; index++ {
+		if index%1024 == 0 && ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+
 		seen = seen[1:]
 
 		if len(seen) == 0 {
@@ -94,8 +104,9 @@ func solve(salt []byte, fn Hasher) []key {
 			}
 
 			keys = append(keys, key{index, hs})
-			if len(keys) == 64 {
-				return keys
+			progress.Add(1)
+			if len(keys) == keyCount {
+				return keys, nil
 			}
 
 			break
@@ -111,8 +122,11 @@ func hashSalt2016(salt []byte, index uint) string {
 	return hash
 }
 
-func part2(input []byte) string {
-	keys := solve(input, hashSalt2016)
+func part2(ctx context.Context, input []byte) (string, error) {
+	keys, err := solve(ctx, input, hashSalt2016)
+	if err != nil {
+		return "", err
+	}
 
-	return fmt.Sprint(keys[len(keys)-1].index)
+	return fmt.Sprint(keys[len(keys)-1].index), nil
 }
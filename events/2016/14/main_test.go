@@ -1,9 +1,15 @@
-package main
+package day14
 
-import "testing"
+import (
+	"context"
+	"testing"
+)
 
 func Test_part1(t *testing.T) {
-	got := part1([]byte("abc"))
+	got, err := part1(context.Background(), []byte("abc"))
+	if err != nil {
+		t.Fatal(err)
+	}
 	want := "22728"
 	if got != want {
 		t.Errorf("part1() = %v, want %v", got, want)
@@ -11,7 +17,10 @@ func Test_part1(t *testing.T) {
 }
 
 func Test_part2(t *testing.T) {
-	got := part2([]byte("abc"))
+	got, err := part2(context.Background(), []byte("abc"))
+	if err != nil {
+		t.Fatal(err)
+	}
 	want := "22551"
 	if got != want {
 		t.Errorf("part2() = %v, want %v", got, want)
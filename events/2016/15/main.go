@@ -1,4 +1,4 @@
-package main
+package day15
 
 import (
 	"fmt"
@@ -10,10 +10,8 @@ import (
 
 type Input []Disc
 
-func main() {
-	event := aoc.New(2016, 15, parse)
-	fmt.Println("1:", aoc.Must(event.Run(part1)))
-	fmt.Println("2:", aoc.Must(event.Run(part2)))
+func init() {
+	aoc.Register(2016, 15, parse, part1, part2)
 }
 
 type Disc struct {
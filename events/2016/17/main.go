@@ -1,4 +1,4 @@
-package main
+package day17
 
 import (
 	"crypto/md5"
@@ -7,16 +7,15 @@ import (
 	"io"
 
 	"github.com/pimvanhespen/advent-of-code/pkg/aoc"
+	"github.com/pimvanhespen/advent-of-code/pkg/geom"
 )
 
 type Input struct {
 	Passcode string
 }
 
-func main() {
-	event := aoc.New(2016, 17, parse)
-	fmt.Println("1:", aoc.Must(event.Run(part1)))
-	fmt.Println("2:", aoc.Must(event.Run(part2)))
+func init() {
+	aoc.Register(2016, 17, parse, part1, part2)
 }
 
 type Direction uint8
@@ -103,9 +102,7 @@ func part1(input Input) string {
 	return leastPath
 }
 
-type Vec2 struct {
-	X, Y int
-}
+type Vec2 = geom.Vec2[int]
 
 func accessible(v Vec2) bool {
 	return v.X >= 0 && v.X < 4 && v.Y >= 0 && v.Y < 4
@@ -129,7 +126,7 @@ func location(path []byte) Vec2 {
 }
 
 func finished(path []byte) bool {
-	return location(path) == Vec2{3, 3}
+	return location(path) == Vec2{X: 3, Y: 3}
 }
 
 func part2(input Input) string {
@@ -1,4 +1,4 @@
-package main
+package day18
 
 import (
 	"bytes"
@@ -13,10 +13,8 @@ type Input struct {
 	Rows int
 }
 
-func main() {
-	event := aoc.New(2016, 18, parse)
-	fmt.Println("1:", aoc.Must(event.Run(part1)))
-	fmt.Println("2:", aoc.Must(event.Run(part2)))
+func init() {
+	aoc.Register(2016, 18, parse, part1, part2)
 }
 
 func parse(r io.Reader) (Input, error) {
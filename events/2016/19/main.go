@@ -1,7 +1,6 @@
-package main
+package day19
 
 import (
-	"fmt"
 	"github.com/pimvanhespen/advent-of-code/pkg/aoc"
 	"io"
 	"math/bits"
@@ -12,10 +11,8 @@ type Input struct {
 	Number int
 }
 
-func main() {
-	event := aoc.New(2016, 19, parse)
-	fmt.Println("1:", aoc.Must(event.Run(part1)))
-	fmt.Println("2:", aoc.Must(event.Run(part2)))
+func init() {
+	aoc.Register(2016, 19, parse, part1, part2)
 }
 
 func parse(r io.Reader) (Input, error) {
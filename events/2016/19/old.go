@@ -1,4 +1,4 @@
-package main
+package day19
 
 // This file contains older versions of the solution, which are kept for reference.
 
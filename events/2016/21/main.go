@@ -1,8 +1,7 @@
-package main
+package day21
 
 import (
 	"bytes"
-	"fmt"
 	"github.com/pimvanhespen/advent-of-code/pkg/aoc"
 	"io"
 	"slices"
@@ -15,10 +14,8 @@ type Input struct {
 	Instructions []Instruction
 }
 
-func main() {
-	event := aoc.New(2016, 21, parse)
-	fmt.Println("1:", aoc.Must(event.Run(part1)))
-	fmt.Println("2:", aoc.Must(event.Run(part2)))
+func init() {
+	aoc.Register(2016, 21, parse, part1, part2)
 }
 
 func parse(reader io.Reader) (Input, error) {
@@ -1,13 +1,14 @@
-package main
+package day22
 
 import (
 	"fmt"
 	"github.com/pimvanhespen/advent-of-code/pkg/aoc"
 	"github.com/pimvanhespen/advent-of-code/pkg/astar"
 	"github.com/pimvanhespen/advent-of-code/pkg/datastructures/heap"
+	"github.com/pimvanhespen/advent-of-code/pkg/geom"
+	"github.com/pimvanhespen/advent-of-code/pkg/grid"
 	"io"
-	"regexp"
-	"strconv"
+	"strings"
 )
 
 type Node struct {
@@ -49,33 +50,18 @@ type Input struct {
 	Nodes []Node
 }
 
-func main() {
-	event := aoc.New(2016, 22, parse)
-	fmt.Println("1:", aoc.Must(event.Run(part1)))
-	fmt.Println("2:", aoc.Must(event.Run(part2)))
+func init() {
+	aoc.Register(2016, 22, parse, part1, part2)
 }
 
-var re = regexp.MustCompile(`^/dev/grid/node-x(\d+)-y(\d+) +(\d+)T +(\d+)T +(\d+)T +(\d+)%`)
+var nodePattern = aoc.Scanf[Node]("/dev/grid/node-x{X}-y{Y} {Size}T {Used}T {Free}T {Use}%")
 
 func parse(r io.Reader) (Input, error) {
 	nodes, err := aoc.ParseLines(r, func(line string) (Node, error) {
-		if line[0] != '/' {
+		if !strings.HasPrefix(line, "/") {
 			return Node{}, aoc.IgnoreLine
 		}
-
-		var n Node
-		matches := re.FindStringSubmatch(line)
-		if len(matches) != 7 {
-			return n, fmt.Errorf("invalid line: %s", line)
-		}
-		n.X = aoc.Must(strconv.Atoi(matches[1]))
-		n.Y = aoc.Must(strconv.Atoi(matches[2]))
-		n.Size = aoc.Must(strconv.Atoi(matches[3]))
-		n.Used = aoc.Must(strconv.Atoi(matches[4]))
-		n.Free = aoc.Must(strconv.Atoi(matches[5]))
-		n.Use = aoc.Must(strconv.Atoi(matches[6]))
-
-		return n, nil
+		return nodePattern.Parse(line)
 	})
 	if err != nil {
 		return Input{}, err
@@ -106,8 +92,6 @@ const (
 	Full  State = '#'
 )
 
-type Grid [][]byte
-
 var _ astar.Node = (*asNode)(nil)
 
 type asNode struct {
@@ -135,16 +119,12 @@ func (a *asNode) Equals(node astar.Node) bool {
 
 func part2(input Input) string {
 
-	var width int
-	{
-		var x int
-		for _, n := range input.Nodes {
-			x = max(x, n.X)
-		}
-		width = x + 1
+	var width, height int
+	for _, n := range input.Nodes {
+		width, height = max(width, n.X+1), max(height, n.Y+1)
 	}
 
-	asNodes := make(map[Coord]*asNode)
+	nodes := grid.New[*asNode](width, height)
 	for _, n := range input.Nodes {
 		var value State
 
@@ -157,44 +137,30 @@ func part2(input Input) string {
 			value = Used
 		}
 
-		asNodes[Coord{X: n.X, Y: n.Y}] = &asNode{
-			coord: Coord{X: n.X, Y: n.Y},
+		coord := Coord{X: n.X, Y: n.Y}
+		nodes.Set(coord, &asNode{
+			coord: coord,
 			value: value,
-		}
+		})
 	}
 
-	for _, n := range asNodes {
-		for _, offset := range []Coord{{X: 0, Y: -1}, {X: 0, Y: 1}, {X: -1, Y: 0}, {X: 1, Y: 0}} {
-			coord := Coord{X: n.coord.X + offset.X, Y: n.coord.Y + offset.Y}
-			if node, ok := asNodes[coord]; ok {
-				if node.value == Full {
-					continue
-				}
-
+	var begin astar.Node
+	nodes.Each(func(p Coord, n *asNode) {
+		for _, q := range nodes.Neighbours4(p) {
+			if node := nodes.At(q); node.value != Full {
 				n.neighbours = append(n.neighbours, astar.Neighbor{
 					Node: node,
 					Cost: 1,
 				})
 			}
 		}
-	}
 
-	var begin, end astar.Node
-	for _, n := range asNodes {
 		if n.value == Empty {
 			begin = n
-			break
 		}
-	}
+	})
 
-	{
-		lastOnFirstRow := Coord{X: width - 2, Y: 0}
-		f, ok := asNodes[lastOnFirstRow]
-		if !ok {
-			panic("not found")
-		}
-		end = f
-	}
+	end := nodes.At(Coord{X: width - 2, Y: 0})
 
 	// Assumes there is unobstructed path on x[0] from begin to end
 	path := astar.AStar(heap.NewMin[float64, astar.Node](), begin, end, heuristic)
@@ -203,20 +169,7 @@ func part2(input Input) string {
 }
 
 func heuristic(a, b astar.Node) float64 {
-	return float64(manhattan(a.(*asNode).coord, b.(*asNode).coord))
-}
-
-type Coord struct {
-	X, Y int
+	return float64(geom.Manhattan(a.(*asNode).coord, b.(*asNode).coord))
 }
 
-func abs(a int) int {
-	if a < 0 {
-		return -a
-	}
-	return a
-}
-
-func manhattan(a, b Coord) int {
-	return abs(a.X-b.X) + abs(a.Y-b.Y)
-}
+type Coord = geom.Vec2[int]
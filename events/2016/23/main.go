@@ -1,20 +1,19 @@
-package main
+package day23
 
 import (
+	"context"
 	"fmt"
 	"github.com/pimvanhespen/advent-of-code/pkg/aoc"
 	"io"
-	"log"
+	"log/slog"
 	"strconv"
 	"strings"
 )
 
 type Input = [][]string
 
-func main() {
-	event := aoc.New(2016, 23, parse)
-	fmt.Println("1:", aoc.Must(event.Run(part1)))
-	fmt.Println("2:", aoc.Must(event.Run(part2)))
+func init() {
+	aoc.RegisterContext(2016, 23, parse, part1, part2)
 }
 
 func parse(r io.Reader) (Input, error) {
@@ -23,22 +22,26 @@ func parse(r io.Reader) (Input, error) {
 	})
 }
 
-func part1(input Input) string {
+func part1(ctx context.Context, input Input) (string, error) {
 	c := NewComputer(input)
 	c.A = 7
 
-	c.Run()
+	if err := c.Run(ctx); err != nil {
+		return "", err
+	}
 
-	return aoc.Result(c.A)
+	return aoc.Result(c.A), nil
 }
 
-func part2(input Input) string {
+func part2(ctx context.Context, input Input) (string, error) {
 	c := NewComputer(input)
 	c.A = 12
 
-	c.Run()
+	if err := c.Run(ctx); err != nil {
+		return "", err
+	}
 
-	return aoc.Result(c.A)
+	return aoc.Result(c.A), nil
 }
 
 func isRegister(s string) bool {
@@ -131,11 +134,16 @@ func (c *Computer) toggle(at int) {
 	}
 }
 
-func (c *Computer) Run() {
+// Run runs the program until it halts or ctx is done.
+func (c *Computer) Run(ctx context.Context) error {
 	var stackPtr int
 	var cycles int
 
 	for stackPtr >= 0 && stackPtr < len(c.Program) {
+		if cycles%(1<<20) == 0 && ctx.Err() != nil {
+			return ctx.Err()
+		}
+
 		cycles++
 		v := c.Program[stackPtr]
 
@@ -161,7 +169,8 @@ func (c *Computer) Run() {
 		stackPtr++
 	}
 
-	log.Println("cycles:", cycles)
+	aoc.Logger(ctx).Info("Halted", slog.Int("Cycles", cycles))
+	return nil
 }
 
 type Increment struct {
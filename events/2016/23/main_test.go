@@ -1,6 +1,7 @@
-package main
+package day23
 
 import (
+	"context"
 	"log"
 	"strings"
 	"testing"
@@ -62,7 +63,11 @@ func Test_part1(t *testing.T) {
 				t.Fatal(err)
 			}
 
-			if got := part1(in); got != tt.want {
+			got, err := part1(context.Background(), in)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
 				t.Errorf("part1() = %v, want %v", got, tt.want)
 			}
 		})
@@ -87,7 +92,7 @@ func Test_part1_ref(t *testing.T) {
 			}
 
 			r := NewComputer(in)
-			r.Run()
+			r.Run(context.Background())
 
 			got := r.A
 
@@ -108,7 +113,7 @@ func BenchmarkSomething(b *testing.B) {
 	b.Run("Computer", func(b *testing.B) {
 		for i := 0; i < b.N; i++ {
 			r := NewComputer(in)
-			r.Run()
+			r.Run(context.Background())
 			v = r.A
 		}
 	})
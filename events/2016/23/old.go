@@ -1,8 +1,7 @@
-package main
+package day23
 
 import (
 	"fmt"
-	"log"
 	"strconv"
 )
 
@@ -51,7 +50,6 @@ func (c *ComputerV1) Tgl(arg string) {
 		panic(fmt.Sprintf("unknown instruction %s", c.program[ptr][0]))
 	}
 
-	log.Println("toggling", ptr, c.program[ptr], "to", newInstr)
 	c.program[ptr][0] = newInstr
 }
 
@@ -1,24 +1,24 @@
-package main
+package day24
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"github.com/pimvanhespen/advent-of-code/pkg/astar"
 	"github.com/pimvanhespen/advent-of-code/pkg/datastructures/heap"
 	"io"
-	"log"
+	"log/slog"
 	"math"
 	"math/bits"
 
 	"github.com/pimvanhespen/advent-of-code/pkg/aoc"
+	"github.com/pimvanhespen/advent-of-code/pkg/geom"
 )
 
 type Input [][]byte
 
-func main() {
-	event := aoc.New(2016, 24, parse)
-	fmt.Println("1:", aoc.Must(event.Run(part1)))
-	fmt.Println("2:", aoc.Must(event.Run(part2)))
+func init() {
+	aoc.RegisterContext(2016, 24, parse, part1, part2)
 }
 
 func parse(r io.Reader) (Input, error) {
@@ -32,30 +32,28 @@ func parse(r io.Reader) (Input, error) {
 	return lines, nil
 }
 
-func part1(input Input) string {
+func part1(ctx context.Context, input Input) (string, error) {
 	distances := findDistances(input)
 
 	all := uint64(1<<len(distances) - 1)
 
-	res := shortestPath(distances, func(path Path) bool {
+	res := shortestPath(aoc.Logger(ctx), distances, func(path Path) bool {
 		return all == path.seen
 	})
-	return fmt.Sprint(res)
+	return fmt.Sprint(res), nil
 }
 
-func part2(input Input) string {
+func part2(ctx context.Context, input Input) (string, error) {
 	distances := findDistances(input)
 
 	all := uint64(1<<len(distances) - 1)
-	res := shortestPath(distances, func(path Path) bool {
+	res := shortestPath(aoc.Logger(ctx), distances, func(path Path) bool {
 		return all == path.seen && path.route[len(path.route)-1] == '0'
 	})
-	return fmt.Sprint(res)
+	return fmt.Sprint(res), nil
 }
 
-type Vec2 struct {
-	X, Y int
-}
+type Vec2 = geom.Vec2[int]
 
 var _ astar.Node = &Node{}
 
@@ -82,7 +80,7 @@ func Heuristic(a, b astar.Node) float64 {
 	ca := a.(*Node).Coord
 	cb := b.(*Node).Coord
 
-	return float64(abs(ca.X-cb.X) + abs(ca.Y-cb.Y))
+	return float64(geom.Manhattan(ca, cb))
 }
 
 type Distances map[byte]map[byte]int
@@ -174,7 +172,7 @@ type Path struct {
 	seen  uint64
 }
 
-func shortestPath(distances Distances, isComplete CompleteFunc) int {
+func shortestPath(log *slog.Logger, distances Distances, isComplete CompleteFunc) int {
 	begin := Path{
 		route: "0",
 		cost:  0,
@@ -216,7 +214,7 @@ func shortestPath(distances Distances, isComplete CompleteFunc) int {
 
 		if isComplete(path) {
 			// we have a path
-			log.Println("found", path)
+			log.Info("Found path", slog.String("Route", path.route), slog.Int("Cost", path.cost))
 			if path.cost < least.cost {
 				least = path
 			}
@@ -243,11 +241,3 @@ func shortestPath(distances Distances, isComplete CompleteFunc) int {
 	}
 	return least.cost
 }
-
-func abs(a int) int {
-	if a < 0 {
-		return -a
-	}
-
-	return a
-}
@@ -1,11 +1,11 @@
-package main
+package day25
 
 import (
 	"context"
 	"errors"
 	"fmt"
 	"io"
-	"log"
+	"log/slog"
 	"strconv"
 	"strings"
 	"time"
@@ -15,9 +15,8 @@ import (
 
 type Input = [][]string
 
-func main() {
-	event := aoc.New(2016, 25, parse)
-	fmt.Println("1:", aoc.Must(event.Run(part1)))
+func init() {
+	aoc.RegisterContext(2016, 25, parse, part1, nil)
 }
 
 func parse(r io.Reader) (Input, error) {
@@ -26,14 +25,18 @@ func parse(r io.Reader) (Input, error) {
 	})
 }
 
-func part1(input Input) string {
+func part1(ctx context.Context, input Input) (string, error) {
 	c := NewComputer(input)
 
 	a := 0
 	for {
+		if ctx.Err() != nil {
+			return "", ctx.Err()
+		}
+
 		a++
 		err := func(a int) error {
-			ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+			ctx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
 			defer cancel()
 
 			c.Reset()
@@ -46,8 +49,8 @@ func part1(input Input) string {
 			panic("no error")
 		case errors.Is(err, ErrBadSignal):
 			// do nothing
-		case errors.Is(err, context.DeadlineExceeded):
-			return aoc.Result(a)
+		case errors.Is(err, context.DeadlineExceeded) && ctx.Err() == nil:
+			return aoc.Result(a), nil
 		}
 	}
 }
@@ -194,7 +197,7 @@ func (c *Computer) Run(ctx context.Context) error {
 		stackPtr++
 	}
 
-	log.Println("cycles:", cycles)
+	aoc.Logger(ctx).Info("Halted", slog.Int("Cycles", cycles))
 	return nil
 }
 
@@ -1,4 +1,4 @@
-package main
+package day01
 
 import (
 	"fmt"
@@ -9,10 +9,8 @@ import (
 
 type Input = []byte
 
-func main() {
-	event := aoc.New(2017, 1, parse)
-	fmt.Println("1:", aoc.Must(event.Run(part1)))
-	fmt.Println("2:", aoc.Must(event.Run(part2)))
+func init() {
+	aoc.Register(2017, 1, parse, part1, part2)
 }
 
 func parse(r io.Reader) (Input, error) {
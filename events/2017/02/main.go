@@ -1,7 +1,6 @@
-package main
+package day02
 
 import (
-	"fmt"
 	"io"
 	"math"
 	"strconv"
@@ -12,10 +11,8 @@ import (
 
 type Input [][]int
 
-func main() {
-	event := aoc.New(2017, 2, parse)
-	fmt.Println("1:", aoc.Must(event.Run(part1)))
-	fmt.Println("2:", aoc.Must(event.Run(part2)))
+func init() {
+	aoc.Register(2017, 2, parse, part1, part2)
 }
 
 func parse(r io.Reader) (Input, error) {
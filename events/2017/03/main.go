@@ -1,4 +1,4 @@
-package main
+package day03
 
 import (
 	"fmt"
@@ -10,10 +10,8 @@ import (
 
 type Input = int
 
-func main() {
-	event := aoc.New(2017, 3, parse)
-	fmt.Println("1:", aoc.Must(event.Run(part1)))
-	fmt.Println("2:", aoc.Must(event.Run(part2)))
+func init() {
+	aoc.Register(2017, 3, parse, part1, part2)
 }
 
 func parse(r io.Reader) (Input, error) {
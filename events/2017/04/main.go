@@ -1,4 +1,4 @@
-package main
+package day04
 
 import (
 	"fmt"
@@ -11,10 +11,8 @@ import (
 
 type Input [][]string
 
-func main() {
-	event := aoc.New(2017, 4, parse)
-	fmt.Println("1:", aoc.Must(event.Run(part1)))
-	fmt.Println("2:", aoc.Must(event.Run(part2)))
+func init() {
+	aoc.Register(2017, 4, parse, part1, part2)
 }
 
 func parse(r io.Reader) (Input, error) {
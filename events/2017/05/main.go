@@ -1,7 +1,6 @@
-package main
+package day05
 
 import (
-	"fmt"
 	"io"
 	"strconv"
 
@@ -10,10 +9,8 @@ import (
 
 type Input []int
 
-func main() {
-	event := aoc.New(2017, 5, parse)
-	fmt.Println("1:", aoc.Must(event.Run(part1)))
-	fmt.Println("2:", aoc.Must(event.Run(part2)))
+func init() {
+	aoc.Register(2017, 5, parse, part1, part2)
 }
 
 func parse(r io.Reader) (Input, error) {
@@ -1,4 +1,4 @@
-package main
+package day07
 
 import (
 	"fmt"
@@ -30,10 +30,8 @@ func (n *Node) TotalWeight() int {
 
 type Input []*Node
 
-func main() {
-	event := aoc.New(2017, 7, parse)
-	fmt.Println("1:", aoc.Must(event.Run(part1)))
-	fmt.Println("2:", aoc.Must(event.Run(part2)))
+func init() {
+	aoc.Register(2017, 7, parse, part1, part2)
 }
 
 func parse(r io.Reader) (Input, error) {
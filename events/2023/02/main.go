@@ -1,4 +1,4 @@
-package main
+package day02
 
 import (
 	"fmt"
@@ -11,7 +11,7 @@ import (
 
 type Game struct {
 	ID       int
-	Revealed []Cubes
+	Revealed []Cubes `scan:",sep=;"`
 }
 
 func (g Game) Required() Cubes {
@@ -28,62 +28,39 @@ type Cubes struct {
 	Red, Green, Blue int
 }
 
-func CubesFromString(s string) Cubes {
-	var k Cubes
-	parts := strings.Split(s, ", ")
-	for _, part := range parts {
-		switch {
-		case strings.HasSuffix(part, "red"):
-			n, err := strconv.Atoi(strings.TrimSuffix(part, " red"))
-			if err != nil {
-				panic(err)
-			}
+// UnmarshalText reads revealed cubes, like "3 blue, 4 red".
+func (k *Cubes) UnmarshalText(text []byte) error {
+	*k = Cubes{}
+	for _, part := range strings.Split(string(text), ", ") {
+		count, color, _ := strings.Cut(part, " ")
+		n, err := strconv.Atoi(count)
+		if err != nil {
+			return fmt.Errorf("cubes %q: %w", part, err)
+		}
+		switch color {
+		case "red":
 			k.Red = n
-		case strings.HasSuffix(part, "green"):
-			n, err := strconv.Atoi(strings.TrimSuffix(part, " green"))
-			if err != nil {
-				panic(err)
-			}
+		case "green":
 			k.Green = n
-		case strings.HasSuffix(part, "blue"):
-			n, err := strconv.Atoi(strings.TrimSuffix(part, " blue"))
-			if err != nil {
-				panic(err)
-			}
+		case "blue":
 			k.Blue = n
 		default:
-			panic("unknown kube")
+			return fmt.Errorf("unknown color %q", color)
 		}
 	}
-	return k
+	return nil
 }
 
 type Input []Game
 
-func main() {
-	event := aoc.New(2023, 2, parse)
-	fmt.Println("1:", aoc.Must(event.Run(part1)))
-	fmt.Println("2:", aoc.Must(event.Run(part2)))
+func init() {
+	aoc.Register(2023, 2, parse, part1, part2)
 }
 
-func parse(r io.Reader) (Input, error) {
-	return aoc.ParseLines(r, func(line string) (Game, error) {
-
-		parts := strings.Split(line, ": ")
-		id := aoc.Must(strconv.Atoi(strings.Split(parts[0], " ")[1])) // Game (1)
-		sets := strings.Split(parts[1], "; ")
+var gamePattern = aoc.Scanf[Game]("Game {ID}: {Revealed}")
 
-		revealed := make([]Cubes, len(sets))
-		for i, set := range sets {
-			revealed[i] = CubesFromString(set)
-		}
-
-		game := Game{
-			ID:       id,
-			Revealed: revealed,
-		}
-		return game, nil
-	})
+func parse(r io.Reader) (Input, error) {
+	return gamePattern.Lines(r)
 }
 
 func part1(input Input) string {
@@ -1,4 +1,4 @@
-package main
+package day03
 
 import (
 	"bytes"
@@ -7,12 +7,11 @@ import (
 	"strconv"
 
 	"github.com/pimvanhespen/advent-of-code/pkg/aoc"
+	"github.com/pimvanhespen/advent-of-code/pkg/geom"
 )
 
-func main() {
-	event := aoc.New(2023, 3, parse)
-	fmt.Println("1:", aoc.Must(event.Run(part1)))
-	fmt.Println("2:", aoc.Must(event.Run(part2)))
+func init() {
+	aoc.Register(2023, 3, parse, part1, part2)
 }
 
 func parse(r io.Reader) (Input, error) {
@@ -70,9 +69,7 @@ func part2(input Input) string {
 	return fmt.Sprint(sum)
 }
 
-type Vec2 struct {
-	X, Y int
-}
+type Vec2 = geom.Vec2[int]
 
 type Input [][]byte
 
@@ -1,15 +1,15 @@
-package main
+package day05
 
 import (
-	"bufio"
+	"context"
 	"fmt"
 	"io"
 	"math"
-	"regexp"
 	"strconv"
 	"strings"
 
 	"github.com/pimvanhespen/advent-of-code/pkg/aoc"
+	"github.com/pimvanhespen/advent-of-code/pkg/interval"
 )
 
 type Input struct {
@@ -17,17 +17,17 @@ type Input struct {
 	Maps  []Map
 }
 
+// String formats the input as the puzzle does, with a blank line after the
+// seeds and between the maps.
 func (i Input) String() string {
 	var sb strings.Builder
 	sb.WriteString("seeds: ")
 	sb.WriteString(strings.Join(intsToAs(i.Seeds), " "))
-	sb.WriteByte('\n')
 	for _, m := range i.Maps {
-		_, _ = fmt.Fprintf(&sb, "%s-to-%s map:\n", m.From, m.To)
+		_, _ = fmt.Fprintf(&sb, "\n\n%s-to-%s map:", m.From, m.To)
 		for _, s := range m.Scales {
-			_, _ = fmt.Fprintf(&sb, "%d %d %d\n", s.Dst, s.Src, s.Len)
+			_, _ = fmt.Fprintf(&sb, "\n%d %d %d", s.Dst, s.Src, s.Len)
 		}
-		sb.WriteByte('\n')
 	}
 	return sb.String()
 }
@@ -46,51 +46,76 @@ func (m Map) Next(n int) int {
 	return n
 }
 
-type Scale struct {
-	Dst, Src, Len int
+// Table returns the mapping of the scales.
+func (m Map) Table() interval.Table[int] {
+	t := make(interval.Table[int], len(m.Scales))
+	for i, s := range m.Scales {
+		t[i] = interval.Offset[int]{Src: interval.Span(s.Src, s.Len), Delta: s.Dst - s.Src}
+	}
+	return t
 }
 
-func main() {
-	event := aoc.New(2023, 5, parse)
-	fmt.Println("1:", aoc.Must(event.Run(part1)))
-	fmt.Println("2:", aoc.Must(event.Run(part2)))
+// Range is a half-open range of numbers.
+type Range = interval.Interval[int]
+
+// Mapping is a part of a range and where the map sends it.
+type Mapping struct {
+	Before, After Range
 }
 
-func parse(r io.Reader) (Input, error) {
-	scanner := bufio.NewScanner(r)
+// NextMapping splits r where the scales of the map begin and end, and maps
+// every part.
+func (m Map) NextMapping(r Range) []Mapping {
+	t := m.Table()
+
+	parts := interval.NewSet(r).Split(t.Boundaries()...)
+
+	mappings := make([]Mapping, len(parts))
+	for i, p := range parts {
+		mappings[i] = Mapping{
+			Before: p,
+			After:  p.Shift(t.Map(p.From) - p.From),
+		}
+	}
+	return mappings
+}
+
+type Scale struct {
+	Dst, Src, Len int
+}
 
-	var input Input
+func init() {
+	aoc.RegisterContext(2023, 5, parse, aoc.SolverFunc[Input](part1).Context(), part2)
+}
 
-	scanner.Scan()
-	seeds := Ints(scanner.Text())
-	scanner.Scan() // Skips line
+var (
+	parser       = aoc.Sections(aoc.Line(aoc.Ints), aoc.Each(parseMap))
+	titlePattern = aoc.Scanf[Map]("{From}-to-{To} map:")
+	scalePattern = aoc.Scanf[Scale]("{Dst} {Src} {Len}")
+)
 
-	for scanner.Scan() {
-		aToBreg.FindAllStringSubmatch(scanner.Text(), -1)
-		from, to := aToBreg.FindStringSubmatch(scanner.Text())[1], aToBreg.FindStringSubmatch(scanner.Text())[2]
+func parse(r io.Reader) (Input, error) {
+	p, err := parser(r)
+	if err != nil {
+		return Input{}, err
+	}
+	return Input{Seeds: p.First, Maps: p.Second}, nil
+}
 
-		m := Map{
-			From: from,
-			To:   to,
-		}
+func parseMap(s aoc.Section) (Map, error) {
+	title, scales := s.Cut(1)
 
-		for scanner.Scan() {
-			if scanner.Text() == "" {
-				break
-			}
-			var scale Scale
-			_, err := fmt.Sscanf(scanner.Text(), "%d %d %d", &scale.Dst, &scale.Src, &scale.Len)
-			if err != nil {
-				return Input{}, fmt.Errorf("scanning scale: %w", err)
-			}
-			m.Scales = append(m.Scales, scale)
-		}
+	m, err := aoc.Line(titlePattern.Parse)(title)
+	if err != nil {
+		return Map{}, err
+	}
 
-		input.Maps = append(input.Maps, m)
+	m.Scales, err = aoc.Lines(scalePattern.Parse)(scales)
+	if err != nil {
+		return Map{}, err
 	}
 
-	input.Seeds = seeds
-	return input, nil
+	return m, nil
 }
 
 func part1(input Input) string {
@@ -120,39 +145,39 @@ func part1(input Input) string {
 	return aoc.Result(lo)
 }
 
-func part2(input Input) string {
-	lo := math.MaxInt
-
+func part2(ctx context.Context, input Input) (string, error) {
 	m := make(map[string]Map)
 
 	for _, ma := range input.Maps {
 		m[ma.From] = ma
 	}
 
-	seeds := make([]int, 0, len(input.Seeds))
-
+	var ranges []Range
 	for i := 0; i < len(input.Seeds); i += 2 {
-		begin, ln := input.Seeds[i], input.Seeds[i+1]
-		for j := begin; j < begin+ln; j++ {
-			seeds = append(seeds, j)
-		}
+		ranges = append(ranges, interval.Span(input.Seeds[i], input.Seeds[i+1]))
 	}
 
-	for _, seed := range seeds {
-		typ := "seed"
+	// map whole ranges of seeds at once instead of every seed
+	set := interval.NewSet(ranges...)
 
-		for typ != "location" {
-			ma := m[typ]
-			seed = ma.Next(seed)
-			typ = ma.To
-		}
+	typ := "seed"
 
-		if seed < lo {
-			lo = seed
+	for typ != "location" {
+		if ctx.Err() != nil {
+			return "", ctx.Err()
 		}
+
+		ma := m[typ]
+		set = ma.Table().MapSet(set)
+		typ = ma.To
 	}
 
-	return aoc.Result(lo)
+	lo, ok := set.Min()
+	if !ok {
+		return "", fmt.Errorf("no seeds")
+	}
+
+	return aoc.Result(lo), nil
 }
 
 func intsToAs(s []int) []string {
@@ -162,17 +187,3 @@ func intsToAs(s []int) []string {
 	}
 	return ret
 }
-
-var aToBreg = regexp.MustCompile(`(\w+)-to-(\w+) map:`)
-
-var intreg = regexp.MustCompile(`(-?\d+)`)
-
-func Ints(s string) []int {
-	matches := intreg.FindAllStringSubmatch(s, -1)
-	ret := make([]int, 0, len(matches))
-	for _, m := range matches {
-		i, _ := strconv.Atoi(m[1])
-		ret = append(ret, i)
-	}
-	return ret
-}
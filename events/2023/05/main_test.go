@@ -1,6 +1,7 @@
-package main
+package day05
 
 import (
+	"context"
 	"io"
 	"reflect"
 	"strings"
@@ -58,7 +59,18 @@ func Test_parse(t *testing.T) {
 			args: args{
 				r: strings.NewReader(exampleInput),
 			},
-			want: Input{},
+			want: Input{
+				Seeds: []int{79, 14, 55, 13},
+				Maps: []Map{
+					{From: "seed", To: "soil", Scales: []Scale{{Dst: 50, Src: 98, Len: 2}, {Dst: 52, Src: 50, Len: 48}}},
+					{From: "soil", To: "fertilizer", Scales: []Scale{{Dst: 0, Src: 15, Len: 37}, {Dst: 37, Src: 52, Len: 2}, {Dst: 39, Src: 0, Len: 15}}},
+					{From: "fertilizer", To: "water", Scales: []Scale{{Dst: 49, Src: 53, Len: 8}, {Dst: 0, Src: 11, Len: 42}, {Dst: 42, Src: 0, Len: 7}, {Dst: 57, Src: 7, Len: 4}}},
+					{From: "water", To: "light", Scales: []Scale{{Dst: 88, Src: 18, Len: 7}, {Dst: 18, Src: 25, Len: 70}}},
+					{From: "light", To: "temperature", Scales: []Scale{{Dst: 45, Src: 77, Len: 23}, {Dst: 81, Src: 45, Len: 19}, {Dst: 68, Src: 64, Len: 13}}},
+					{From: "temperature", To: "humidity", Scales: []Scale{{Dst: 0, Src: 69, Len: 1}, {Dst: 1, Src: 0, Len: 69}}},
+					{From: "humidity", To: "location", Scales: []Scale{{Dst: 60, Src: 56, Len: 37}, {Dst: 56, Src: 93, Len: 4}}},
+				},
+			},
 		},
 	}
 	for _, tt := range tests {
@@ -68,9 +80,12 @@ func Test_parse(t *testing.T) {
 				t.Fatalf("parse() error = %v", err)
 			}
 
-			if got.String() != exampleInput {
+			if !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("parse() got = %v, want %v", got, tt.want)
-				t.Log(got.String())
+			}
+
+			if got.String() != exampleInput {
+				t.Errorf("String() = %q, want %q", got.String(), exampleInput)
 			}
 		})
 	}
@@ -113,7 +128,11 @@ func Test_part2(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := part2(tt.input); !reflect.DeepEqual(got, tt.want) {
+			got, err := part2(context.Background(), tt.input)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("part2() = %s, want %s", got, tt.want)
 			}
 		})
@@ -163,11 +182,11 @@ func TestMap_NextMapping(t *testing.T) {
 				{
 					Before: Range{
 						From: 0,
-						To:   1,
+						To:   2,
 					},
 					After: Range{
 						From: 0,
-						To:   1,
+						To:   2,
 					},
 				},
 				{
@@ -1,4 +1,4 @@
-package main
+package day06
 
 import (
 	"fmt"
@@ -14,10 +14,8 @@ type Race struct {
 	Distance int
 }
 
-func main() {
-	event := aoc.New(2023, 6, parse)
-	fmt.Println("1:", aoc.Must(event.Run(part1)))
-	fmt.Println("2:", aoc.Must(event.Run(part2)))
+func init() {
+	aoc.Register(2023, 6, parse, part1, part2)
 }
 
 func parse(r io.Reader) (Input, error) {
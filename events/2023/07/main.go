@@ -1,4 +1,4 @@
-package main
+package day07
 
 import (
 	"fmt"
@@ -53,10 +53,8 @@ func (p Player) String() string {
 	return fmt.Sprintf("%5s %3d", p.Cards, p.Bid)
 }
 
-func main() {
-	event := aoc.New(2023, 7, parse)
-	fmt.Println("1:", aoc.Must(event.Run(part1)))
-	fmt.Println("2:", aoc.Must(event.Run(part2)))
+func init() {
+	aoc.Register(2023, 7, parse, part1, part2)
 }
 
 func parse(r io.Reader) (Input, error) {
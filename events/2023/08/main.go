@@ -1,4 +1,4 @@
-package main
+package day08
 
 import (
 	"fmt"
@@ -27,10 +27,8 @@ func (i Input) String() string {
 	return sb.String()
 }
 
-func main() {
-	event := aoc.New(2023, 8, parse)
-	fmt.Println("1:", aoc.Must(event.Run(part1)))
-	fmt.Println("2:", aoc.Must(event.Run(part2)))
+func init() {
+	aoc.Register(2023, 8, parse, part1, part2)
 }
 
 func parse(r io.Reader) (Input, error) {
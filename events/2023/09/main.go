@@ -1,7 +1,6 @@
-package main
+package day09
 
 import (
-	"fmt"
 	"io"
 
 	"github.com/pimvanhespen/advent-of-code/pkg/aoc"
@@ -9,10 +8,8 @@ import (
 
 type Input [][]int
 
-func main() {
-	event := aoc.New(2023, 9, parse)
-	fmt.Println("1:", aoc.Must(event.Run(part1)))
-	fmt.Println("2:", aoc.Must(event.Run(part2)))
+func init() {
+	aoc.Register(2023, 9, parse, part1, part2)
 }
 
 func part1(input Input) string {
@@ -1,75 +1,32 @@
-package main
+package day10
 
 import (
 	"bytes"
 	"fmt"
-	"github.com/pimvanhespen/advent-of-code/pkg/aoc"
 	"io"
-)
 
-type Vec2 struct {
-	X, Y int
-}
+	"github.com/pimvanhespen/advent-of-code/pkg/aoc"
+	"github.com/pimvanhespen/advent-of-code/pkg/grid"
+)
 
-type Input struct {
-	Width, Height int
-	Grid          []byte
-}
+type Vec2 = grid.Point
 
-func (i Input) Get(pos Vec2) (byte, bool) {
-	if pos.X < 0 || pos.X >= i.Width || pos.Y < 0 || pos.Y >= i.Height {
-		return 0, false
-	}
-	xy := pos.Y*i.Width + pos.X
-	return i.Grid[xy], true
-}
+type Input = grid.Grid[byte]
 
-func (i Input) GetStart() Vec2 {
-	start := bytes.IndexByte(i.Grid, 'S')
-	if start == -1 {
+func getStart(input Input) Vec2 {
+	start, ok := input.Find(func(b byte) bool { return b == 'S' })
+	if !ok {
 		panic("no start found")
 	}
-	return Vec2{start % i.Width, start / i.Width}
+	return start
 }
 
-func (i Input) Set(pos Vec2, b byte) {
-	if pos.X < 0 || pos.X >= i.Width || pos.Y < 0 || pos.Y >= i.Height {
-		return
-	}
-	xy := pos.Y*i.Width + pos.X
-	i.Grid[xy] = b
-}
-
-func (i Input) String() string {
-	var buf bytes.Buffer
-	for y := 0; y < i.Height; y++ {
-		buf.Write(i.Grid[y*i.Width : (y+1)*i.Width])
-		buf.WriteByte('\n')
-	}
-	return buf.String()
-}
-
-func main() {
-	event := aoc.New(2023, 10, parse)
-	fmt.Println("1:", aoc.Must(event.Run(part1)))
-	fmt.Println("2:", aoc.Must(event.Run(part2)))
+func init() {
+	aoc.Register(2023, 10, parse, part1, part2)
 }
 
 func parse(r io.Reader) (Input, error) {
-	b, err := io.ReadAll(r)
-	if err != nil {
-		return Input{}, err
-	}
-
-	b = bytes.TrimSpace(b)
-	width := bytes.IndexByte(b, '\n')
-	height := bytes.Count(b, []byte{'\n'}) + 1
-
-	return Input{
-		Width:  width,
-		Height: height,
-		Grid:   bytes.ReplaceAll(b, []byte{'\n'}, nil),
-	}, nil
+	return grid.Parse(r, grid.Byte)
 }
 
 func part1(input Input) string {
@@ -78,86 +35,23 @@ func part1(input Input) string {
 	return fmt.Sprint(len(loop) / 2)
 }
 
-type Grid struct {
-	Width, Height int
-	Grid          []byte
-}
-
-func NewGrid(width, height int) Grid {
-	return Grid{
-		Width:  width,
-		Height: height,
-		Grid:   make([]byte, width*height),
-	}
-}
-
-func (g *Grid) Draw(pos Vec2, data [][]byte) {
+func draw(g grid.Grid[byte], pos Vec2, data [][]byte) {
 	for y, row := range data {
 		for x, b := range row {
-			g.Set(Vec2{pos.X + x, pos.Y + y}, b)
+			g.Set(Vec2{X: pos.X + x, Y: pos.Y + y}, b)
 		}
 	}
 }
 
-func (g *Grid) Set(pos Vec2, b byte) {
-	if pos.X < 0 || pos.X >= g.Width || pos.Y < 0 || pos.Y >= g.Height {
-		return
-	}
-	xy := pos.Y*g.Width + pos.X
-	g.Grid[xy] = b
-}
-
-func (g *Grid) Fill(pos Vec2, before byte, b byte) {
-	if pos.X < 0 || pos.X >= g.Width || pos.Y < 0 || pos.Y >= g.Height {
-		return
-	}
-
-	xy := pos.Y*g.Width + pos.X
-	if g.Grid[xy] != before {
+func fill(g grid.Grid[byte], pos Vec2, before byte, b byte) {
+	if v, ok := g.Get(pos); !ok || v != before {
 		return
 	}
 
-	g.Grid[xy] = b
-	for _, dir := range Directions {
-		g.Fill(Vec2{pos.X + dir.X, pos.Y + dir.Y}, before, b)
-	}
-}
-
-func (g *Grid) Get(vec2 Vec2) byte {
-	if vec2.X < 0 || vec2.X >= g.Width || vec2.Y < 0 || vec2.Y >= g.Height {
-		return 0
-	}
-	return g.Grid[vec2.Y*g.Width+vec2.X]
-}
-
-func (g *Grid) String() string {
-	var buf bytes.Buffer
-	for y := 0; y < g.Height; y++ {
-		buf.Write(g.Grid[y*g.Width : (y+1)*g.Width])
-		buf.WriteByte('\n')
+	g.Set(pos, b)
+	for _, dir := range grid.Orthogonal {
+		fill(g, pos.Add(dir), before, b)
 	}
-	return buf.String()
-}
-
-func (g *Grid) InBounds(pos Vec2) bool {
-	return pos.X >= 0 && pos.X < g.Width && pos.Y >= 0 && pos.Y < g.Height
-}
-
-func (g *Grid) Neighbours(vec2 Vec2) []byte {
-	var nbrs []byte
-	for y := -1 + vec2.Y; y <= 1+vec2.Y; y++ {
-		for x := -1 + vec2.X; x <= 1+vec2.X; x++ {
-			if x == vec2.X && y == vec2.Y {
-				continue
-			}
-			if !g.InBounds(Vec2{x, y}) {
-				continue
-			}
-			nbrs = append(nbrs, g.Get(Vec2{x, y}))
-		}
-	}
-	return nbrs
-
 }
 
 func part2(input Input) string {
@@ -182,98 +76,92 @@ func part2(input Input) string {
 	}
 
 	// Define the start shape based on the connectors
-	if b, ok := input.Get(Vec2{start.X, start.Y - 1}); ok && bytes.ContainsAny([]byte{b}, "|F7") {
+	if b, ok := input.Get(start.Add(Up)); ok && bytes.ContainsAny([]byte{b}, "|F7") {
 		startShape[0][1] = 'S'
 	}
-	if b, ok := input.Get(Vec2{start.X, start.Y + 1}); ok && bytes.ContainsAny([]byte{b}, "|JL") {
+	if b, ok := input.Get(start.Add(Down)); ok && bytes.ContainsAny([]byte{b}, "|JL") {
 		startShape[2][1] = 'S'
 	}
-	if b, ok := input.Get(Vec2{start.X - 1, start.Y}); ok && bytes.ContainsAny([]byte{b}, string("-LF")) {
+	if b, ok := input.Get(start.Add(Left)); ok && bytes.ContainsAny([]byte{b}, string("-LF")) {
 		startShape[1][0] = 'S'
 	}
-	if b, ok := input.Get(Vec2{start.X + 1, start.Y}); ok && bytes.ContainsAny([]byte{b}, string("-J7")) {
+	if b, ok := input.Get(start.Add(Right)); ok && bytes.ContainsAny([]byte{b}, string("-J7")) {
 		startShape[1][2] = 'S'
 	}
 
 	// upscale the image by 3x, redraw the loop, then use flashflood to fill the rest
 	// upscale
 
-	scaled := NewGrid(input.Width*3, input.Height*3)
+	scaled := grid.New[byte](input.Width()*3, input.Height()*3)
 
 	// redraw grid
-	for y := 0; y < input.Height; y++ {
-		for x := 0; x < input.Width; x++ {
-			pos := Vec2{x * 3, y * 3}
-			switch input.Grid[y*input.Width+x] {
-			case 'F':
-				scaled.Draw(pos, ShapeF)
-			case '7':
-				scaled.Draw(pos, Shape7)
-			case 'J':
-				scaled.Draw(pos, ShapeJ)
-			case 'L':
-				scaled.Draw(pos, ShapeL)
-			case '|':
-				scaled.Draw(pos, ShapePipe)
-			case '-':
-				scaled.Draw(pos, ShapeDash)
-			case 'S':
-				scaled.Draw(pos, startShape)
-			default:
-				scaled.Draw(pos, ShapeFloor)
-			}
+	input.Each(func(p Vec2, b byte) {
+		pos := p.Mul(3)
+		switch b {
+		case 'F':
+			draw(scaled, pos, ShapeF)
+		case '7':
+			draw(scaled, pos, Shape7)
+		case 'J':
+			draw(scaled, pos, ShapeJ)
+		case 'L':
+			draw(scaled, pos, ShapeL)
+		case '|':
+			draw(scaled, pos, ShapePipe)
+		case '-':
+			draw(scaled, pos, ShapeDash)
+		case 'S':
+			draw(scaled, pos, startShape)
+		default:
+			draw(scaled, pos, ShapeFloor)
 		}
-	}
+	})
 
 	// flashflood
-	for x := 0; x < scaled.Width; x++ {
-		scaled.Fill(Vec2{x, 0}, '.', 'O')
-		scaled.Fill(Vec2{x, scaled.Height - 1}, '.', 'O')
+	for x := 0; x < scaled.Width(); x++ {
+		fill(scaled, Vec2{X: x, Y: 0}, '.', 'O')
+		fill(scaled, Vec2{X: x, Y: scaled.Height() - 1}, '.', 'O')
 	}
 
-	for y := 0; y < scaled.Height; y++ {
-		scaled.Fill(Vec2{0, y}, '.', 'O')
-		scaled.Fill(Vec2{scaled.Width - 1, y}, '.', 'O')
+	for y := 0; y < scaled.Height(); y++ {
+		fill(scaled, Vec2{X: 0, Y: y}, '.', 'O')
+		fill(scaled, Vec2{X: scaled.Width() - 1, Y: y}, '.', 'O')
 	}
 
-	for y := 0; y < scaled.Height; y++ {
-		for x := 0; x < scaled.Width; x++ {
-			c := scaled.Get(Vec2{x, y})
-			if c == 'O' || c == 'S' || c == '.' {
-				continue
-			}
-			nbrs := scaled.Neighbours(Vec2{x, y})
-			if bytes.ContainsAny(nbrs, "O") {
-				continue
+	scaled.Each(func(p Vec2, c byte) {
+		if c == 'O' || c == 'S' || c == '.' {
+			return
+		}
+		for _, n := range scaled.Neighbours8(p) {
+			if scaled.At(n) == 'O' {
+				return
 			}
-			scaled.Draw(Vec2{x, y}, ShapeFloor)
 		}
-	}
-
-	// downscale again
-	for y := 0; y < input.Height; y++ {
-		for x := 0; x < input.Width; x++ {
-			input.Set(Vec2{x, y}, scaled.Get(Vec2{x*3 + 1, y*3 + 1}))
+		draw(scaled, p, ShapeFloor)
+	})
+
+	// downscale again, counting the cells inside
+	var inside int
+	input.Each(func(p Vec2, _ byte) {
+		if scaled.At(p.Mul(3).Add(Vec2{X: 1, Y: 1})) == '.' {
+			inside++
 		}
-	}
+	})
 
-	// return inside
-	return fmt.Sprint(bytes.Count(input.Grid, []byte{'.'}))
+	return fmt.Sprint(inside)
 }
 
 var (
-	Up    = Vec2{X: 0, Y: -1}
-	Right = Vec2{X: 1, Y: 0}
-	Down  = Vec2{X: 0, Y: 1}
-	Left  = Vec2{X: -1, Y: 0}
-
-	Directions = []Vec2{Up, Right, Down, Left}
+	Up    = grid.Up
+	Right = grid.Right
+	Down  = grid.Down
+	Left  = grid.Left
 )
 
 func getConnectors(pos Vec2, input Input) []Vec2 {
 	var connectors []Vec2
-	for _, dir := range Directions {
-		next, ok := input.Get(Vec2{pos.X + dir.X, pos.Y + dir.Y})
+	for _, dir := range grid.Orthogonal {
+		next, ok := input.Get(pos.Add(dir))
 		if !ok {
 			continue
 		}
@@ -298,13 +186,13 @@ func getConnectors(pos Vec2, input Input) []Vec2 {
 		}
 	}
 	for i, c := range connectors {
-		connectors[i] = Vec2{pos.X + c.X, pos.Y + c.Y}
+		connectors[i] = pos.Add(c)
 	}
 	return connectors
 }
 
 func getLoop(input Input) []Vec2 {
-	start := input.GetStart()
+	start := getStart(input)
 	connectors := getConnectors(start, input)
 
 	seen := make(map[Vec2]bool)
@@ -357,7 +245,7 @@ func getLinks(curr Vec2, input Input) []Vec2 {
 	}
 
 	for i, link := range links {
-		links[i] = Vec2{curr.X + link.X, curr.Y + link.Y}
+		links[i] = curr.Add(link)
 	}
 
 	return links
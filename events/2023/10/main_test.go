@@ -1,4 +1,4 @@
-package main
+package day10
 
 import (
 	"io"
@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/pimvanhespen/advent-of-code/pkg/aoc"
+	"github.com/pimvanhespen/advent-of-code/pkg/grid"
 )
 
 // exampleInput form the puzzle
@@ -36,11 +37,13 @@ func Test_parse(t *testing.T) {
 			args: args{
 				r: strings.NewReader(exampleInput),
 			},
-			want: Input{
-				Width:  5,
-				Height: 5,
-				Grid:   []byte("-L|F77S-7|L|7||-L-J|L|-JF"),
-			},
+			want: aoc.Must(grid.FromRows([][]byte{
+				[]byte("-L|F7"),
+				[]byte("7S-7|"),
+				[]byte("L|7||"),
+				[]byte("-L-J|"),
+				[]byte("L|-JF"),
+			})),
 		},
 	}
 	for _, tt := range tests {
@@ -1,34 +1,24 @@
-package main
+package day11
 
 import (
 	"fmt"
 	"github.com/pimvanhespen/advent-of-code/pkg/aoc"
+	"github.com/pimvanhespen/advent-of-code/pkg/geom"
+	"github.com/pimvanhespen/advent-of-code/pkg/grid"
 	"io"
 	"sort"
 )
 
-type Input aoc.Grid
+type Input = grid.Grid[byte]
 
-type Vec2 struct {
-	X, Y int
-}
-
-func (v Vec2) String() string {
-	return fmt.Sprintf("(%d, %d)", v.X, v.Y)
-}
+type Vec2 = geom.Vec2[int]
 
-func main() {
-	event := aoc.New(2023, 11, parse)
-	fmt.Println("1:", aoc.Must(event.Run(part1)))
-	fmt.Println("2:", aoc.Must(event.Run(part2)))
+func init() {
+	aoc.Register(2023, 11, parse, part1, part2)
 }
 
 func parse(r io.Reader) (Input, error) {
-	g, err := aoc.ParseGrid(r)
-	if err != nil {
-		return Input{}, err
-	}
-	return Input(g), nil
+	return grid.Parse(r, grid.Byte)
 }
 
 func part1(input Input) string {
@@ -42,13 +32,9 @@ func part2(input Input) string {
 }
 
 func solve(input Input, scale int) int {
-	var galaxies []Vec2
-
-	for i, b := range input.Data {
-		if b == '#' {
-			galaxies = append(galaxies, Vec2{i % input.Width, i / input.Width})
-		}
-	}
+	galaxies := input.FindAll(func(b byte) bool {
+		return b == '#'
+	})
 
 	galaxies = rescale(galaxies, scale)
 
@@ -99,19 +85,8 @@ func sumShortestPaths(galaxies []Vec2) int {
 	var sum int
 	for i, a := range galaxies {
 		for _, b := range galaxies[i+1:] {
-			sum += manhattanDistance(a, b)
+			sum += geom.Manhattan(a, b)
 		}
 	}
 	return sum
 }
-
-func manhattanDistance(a, b Vec2) int {
-	return abs(a.X-b.X) + abs(a.Y-b.Y)
-}
-
-func abs(a int) int {
-	if a < 0 {
-		return -a
-	}
-	return a
-}
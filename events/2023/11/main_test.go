@@ -1,4 +1,4 @@
-package main
+package day11
 
 import (
 	"io"
@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/pimvanhespen/advent-of-code/pkg/aoc"
+	"github.com/pimvanhespen/advent-of-code/pkg/grid"
 )
 
 // exampleInput form the puzzle
@@ -36,7 +37,18 @@ func Test_parse(t *testing.T) {
 			args: args{
 				r: strings.NewReader(exampleInput),
 			},
-			want: Input{},
+			want: aoc.Must(grid.FromRows([][]byte{
+				[]byte("...#......"),
+				[]byte(".......#.."),
+				[]byte("#........."),
+				[]byte(".........."),
+				[]byte("......#..."),
+				[]byte(".#........"),
+				[]byte(".........#"),
+				[]byte(".........."),
+				[]byte(".......#.."),
+				[]byte("#...#....."),
+			})),
 		},
 	}
 	for _, tt := range tests {
@@ -45,8 +57,8 @@ func Test_parse(t *testing.T) {
 			if err != nil {
 				t.Fatalf("parse() error = %v", err)
 			}
-			if !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("parse() got = %v, want %v", got, tt.want)
+			if !grid.Equal(got, tt.want) {
+				t.Errorf("parse() got = \n%vwant \n%v", got, tt.want)
 			}
 		})
 	}
@@ -1,4 +1,4 @@
-package main
+package day12
 
 import (
 	"bytes"
@@ -16,10 +16,8 @@ type Record struct {
 	Broken []int
 }
 
-func main() {
-	event := aoc.New(2023, 12, parse)
-	fmt.Println("1:", aoc.Must(event.Run(part1)))
-	fmt.Println("2:", aoc.Must(event.Run(part2)))
+func init() {
+	aoc.Register(2023, 12, parse, part1, part2)
 }
 
 func parse(r io.Reader) (Input, error) {
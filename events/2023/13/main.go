@@ -1,73 +1,29 @@
-package main
+package day13
 
 import (
-	"bytes"
-	"fmt"
 	"github.com/pimvanhespen/advent-of-code/pkg/aoc"
+	"github.com/pimvanhespen/advent-of-code/pkg/grid"
 	"io"
 )
 
-type Grid [][]byte
-
-func (g Grid) String() string {
-	a, b := flip(g), g
-
-	if len(a) < len(b) {
-		a, b = b, a
-	}
-
-	var buf bytes.Buffer
-	for i := range a {
-		buf.Write(a[i])
-		buf.WriteString("     ")
-		if i < len(b) {
-			buf.Write(b[i])
-		}
-		buf.WriteByte('\n')
-	}
-	return buf.String()
-}
-
-func (g Grid) Copy() Grid {
-	c := make(Grid, len(g))
-	for i, row := range g {
-		c[i] = make([]byte, len(row))
-		copy(c[i], row)
-	}
-	return c
-}
+type Grid = grid.Grid[byte]
 
 type Input []Grid
 
-func main() {
-	event := aoc.New(2023, 13, parse)
-	fmt.Println("1:", aoc.Must(event.Run(part1)))
-	fmt.Println("2:", aoc.Must(event.Run(part2)))
+func init() {
+	aoc.Register(2023, 13, parse, part1, part2)
 }
 
 func parse(r io.Reader) (Input, error) {
-	b, err := io.ReadAll(r)
-	if err != nil {
-		return nil, fmt.Errorf("reading input: %w", err)
-	}
-
-	sets := bytes.Split(b, []byte("\n\n"))
-
-	var grids []Grid
-	for _, set := range sets {
-		lines := bytes.Split(bytes.TrimSpace(set), []byte("\n"))
-		grids = append(grids, lines)
-	}
-
-	return grids, nil
+	return aoc.ParseSections(r, aoc.GridSection(grid.Byte))
 }
 
 func part1(input Input) string {
 
 	var sum int
 
-	for _, grid := range input {
-		sum += score(grid, 0)
+	for _, g := range input {
+		sum += score(g, 0)
 	}
 
 	return aoc.Result(sum)
@@ -75,15 +31,15 @@ func part1(input Input) string {
 
 func part2(input Input) string {
 	var sum int
-	for _, grid := range input {
-		sum += score(grid, 1)
+	for _, g := range input {
+		sum += score(g, 1)
 	}
 	return aoc.Result(sum)
 }
 
-func score(grid Grid, maxSmudge int) int {
-	cols := verticalSmudge(grid, maxSmudge)
-	rows := horizontalSmudge(grid, maxSmudge)
+func score(g Grid, maxSmudge int) int {
+	cols := verticalSmudge(g, maxSmudge)
+	rows := horizontalSmudge(g, maxSmudge)
 	return cols + rows*100
 }
 
@@ -102,44 +58,28 @@ func diff(a, b []byte) int {
 	return count
 }
 
-func verticalSmudge(grid Grid, maxSmudge int) int {
-	return horizontalSmudge(flip(grid), maxSmudge)
-}
-
-func flip(grid Grid) Grid {
-	flipped := make(Grid, len(grid[0]))
-	for i := range flipped {
-		flipped[i] = make([]byte, len(grid))
-	}
-
-	for i := range grid {
-		for j := range grid[i] {
-			flipped[j][i] = grid[i][j]
-		}
-	}
-
-	return flipped
-
+func verticalSmudge(g Grid, maxSmudge int) int {
+	return horizontalSmudge(g.Transpose(), maxSmudge)
 }
 
-func horizontalSmudge(grid Grid, maxSmudge int) int {
+func horizontalSmudge(g Grid, maxSmudge int) int {
 
 	var totals []int
 
 outer:
-	for y := 0; y < len(grid)-1; y++ {
-		if diff(grid[y], grid[y+1]) > maxSmudge {
+	for y := 0; y < g.Height()-1; y++ {
+		if diff(g.Row(y), g.Row(y+1)) > maxSmudge {
 			continue
 		}
 
 		// possible match
-		limit := min(y, len(grid)-1-(y+1)) // least distance to top or bottom
+		limit := min(y, g.Height()-1-(y+1)) // least distance to top or bottom
 
 		var dist int
 		for offset := 0; offset <= limit; offset++ {
 			top, bottom := y-offset, y+1+offset // top and bottom row + offset
 
-			dist += diff(grid[top], grid[bottom])
+			dist += diff(g.Row(top), g.Row(bottom))
 			if dist > maxSmudge {
 				continue outer
 			}
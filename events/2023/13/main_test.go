@@ -1,7 +1,8 @@
-package main
+package day13
 
 import (
 	"github.com/pimvanhespen/advent-of-code/pkg/aoc"
+	"github.com/pimvanhespen/advent-of-code/pkg/grid"
 	"io"
 	"reflect"
 	"strings"
@@ -60,7 +61,7 @@ func Test_parse(t *testing.T) {
 				r: strings.NewReader(exampleInput),
 			},
 			want: Input{
-				Grid{
+				aoc.Must(grid.FromRows([][]byte{
 					[]byte("#.##..##."),
 					[]byte("..#.##.#."),
 					[]byte("##......#"),
@@ -68,8 +69,8 @@ func Test_parse(t *testing.T) {
 					[]byte("..#.##.#."),
 					[]byte("..##..##."),
 					[]byte("#.#.##.#."),
-				},
-				Grid{
+				})),
+				aoc.Must(grid.FromRows([][]byte{
 					[]byte("#...##..#"),
 					[]byte("#....#..#"),
 					[]byte("..##..###"),
@@ -77,7 +78,7 @@ func Test_parse(t *testing.T) {
 					[]byte("#####.##."),
 					[]byte("..##..###"),
 					[]byte("#....#..#"),
-				},
+				})),
 			},
 		},
 	}
@@ -88,8 +89,12 @@ func Test_parse(t *testing.T) {
 				t.Fatalf("parse() error = %v", err)
 			}
 
+			if len(got) != len(tt.want) {
+				t.Fatalf("parse() returned %d grids, want %d", len(got), len(tt.want))
+			}
+
 			for i := range got {
-				if !reflect.DeepEqual(got[i].String(), tt.want[i].String()) {
+				if !grid.Equal(got[i], tt.want[i]) {
 					t.Errorf("parse() got = \n%vwant \n%v", got[i], tt.want[i])
 				}
 			}
@@ -146,37 +151,3 @@ func Test_part2(t *testing.T) {
 		})
 	}
 }
-
-func Test_flip(t *testing.T) {
-	type args struct {
-		grid Grid
-	}
-	tests := []struct {
-		name string
-		args args
-		want Grid
-	}{
-		{
-			name: "example",
-			args: args{
-				grid: Grid{
-					[]byte("####"),
-					[]byte("...."),
-				},
-			},
-			want: Grid{
-				[]byte("#."),
-				[]byte("#."),
-				[]byte("#."),
-				[]byte("#."),
-			},
-		},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			if got := flip(tt.args.grid); !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("flip() = %v, want %v", got, tt.want)
-			}
-		})
-	}
-}
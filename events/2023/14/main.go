@@ -1,137 +1,52 @@
-package main
+package day14
 
 import (
-	"bytes"
-	"fmt"
+	"context"
 	"io"
-	"log"
+	"log/slog"
 
 	"github.com/pimvanhespen/advent-of-code/pkg/aoc"
+	"github.com/pimvanhespen/advent-of-code/pkg/geom"
+	"github.com/pimvanhespen/advent-of-code/pkg/grid"
 )
 
-type Grid [][]byte
+type Grid = grid.Grid[byte]
 
-func (g Grid) Equal(other Grid) bool {
-	if len(g) != len(other) {
-		return false
-	}
-	for i := range g {
-		if !bytes.Equal(g[i], other[i]) {
-			return false
-		}
-	}
-	return true
-}
+type Input = Grid
 
-func (g Grid) String() string {
-	var buf bytes.Buffer
-	for i := range g {
-		buf.Write(g[i])
-		buf.WriteByte('\n')
-	}
-	return buf.String()
-}
-
-type Input Grid
-
-func main() {
-	event := aoc.New(2023, 14, parse)
-	fmt.Println("1:", aoc.Must(event.Run(part1)))
-	fmt.Println("2:", aoc.Must(event.Run(part2)))
+func init() {
+	aoc.RegisterContext(2023, 14, parse, aoc.SolverFunc[Input](part1).Context(), part2)
 }
 
 func parse(r io.Reader) (Input, error) {
-	return aoc.ParseLines(r, func(s string) ([]byte, error) {
-		return []byte(s), nil
-	})
+	return grid.Parse(r, grid.Byte)
 }
 
-type Vec2 struct {
-	X, Y int
-}
-
-func flip90(grid Grid) Grid {
-	flipped := make(Grid, len(grid[0]))
-	for i := range flipped {
-		flipped[i] = make([]byte, len(grid))
-	}
-
-	for i := range grid {
-		for j := range grid[i] {
-			ii := len(grid) - 1 - i
-			flipped[j][ii] = grid[i][j]
-		}
-	}
-
-	return flipped
-}
-
-func flip270(grid Grid) Grid {
-
-	return flip90(flip180(grid))
-
-	//flipped := make(Grid, len(grid[0]))
-	//for i := range flipped {
-	//	flipped[i] = make([]byte, len(grid))
-	//}
-	//
-	//for i := range grid {
-	//	for j := len(grid[i]) - 1; j >= 0; j-- {
-	//		flipped[j][i] = grid[i][j]
-	//	}
-	//}
-	//
-	//return flipped
-}
-
-func flip180(grid Grid) Grid {
-	flipped := make(Grid, len(grid))
-	for i := range flipped {
-		flipped[i] = make([]byte, len(grid[i]))
-	}
-
-	for i := range grid {
-		for j := range grid[i] {
-			i2 := len(grid) - 1 - i
-			flipped[i2][j] = grid[i][len(grid[i])-1-j]
-		}
-	}
-
-	return flipped
-}
-
-var (
-	North = Vec2{0, -1}
-	South = Vec2{0, 1}
-	West  = Vec2{-1, 0}
-	East  = Vec2{1, 0}
-)
-
-func slideBoulders(grid Grid, direction Vec2) Grid {
+func slideBoulders(g Grid, direction geom.Dir) Grid {
 
 	switch direction {
-	case North:
-		grid = flip90(grid)
-	case East:
-	case South:
-		grid = flip270(grid)
-	case West:
-		grid = flip180(grid)
+	case geom.North:
+		g = g.RotateRight()
+	case geom.East:
+	case geom.South:
+		g = g.RotateLeft()
+	case geom.West:
+		g = g.Rotate180()
 	}
 
-	grid = slideBouldersEast(grid)
+	g = slideBouldersEast(g)
 
 	switch direction {
-	case North:
-		grid = flip270(grid)
-	case East:
-	case South:
-		grid = flip90(grid)
-	case West:
-		grid = flip180(grid)
+	case geom.North:
+		g = g.RotateLeft()
+	case geom.East:
+	case geom.South:
+		g = g.RotateRight()
+	case geom.West:
+		g = g.Rotate180()
 	}
 
-	return grid
+	return g
 }
 
 const (
@@ -141,14 +56,15 @@ const (
 )
 
 // slideBouldersEast slides boulders to the east and returns the new grid
-func slideBouldersEast(grid Grid) Grid {
+func slideBouldersEast(g Grid) Grid {
 
 	// bruteforce shift boulders...
-	for y := range grid {
+	for y := 0; y < g.Height(); y++ {
+		row := g.Row(y)
 
-		last := len(grid[y]) - 1
+		last := len(row) - 1
 		for x := last; x >= 0; x-- {
-			switch grid[y][x] {
+			switch row[x] {
 			case Squared:
 				last = x
 			case Round:
@@ -156,23 +72,23 @@ func slideBouldersEast(grid Grid) Grid {
 					continue
 				}
 				// move boulder to front
-				offset := 1 + getLast(grid[y][x+1:last+1], Floor)
+				offset := 1 + getLast(row[x+1:last+1], Floor)
 				if offset == 0 {
 					continue
 				}
-				grid[y][x], grid[y][x+offset] = grid[y][x+offset], grid[y][x] // swap floor and boulder
+				row[x], row[x+offset] = row[x+offset], row[x] // swap floor and boulder
 			case Floor:
 			}
 		}
 	}
-	return grid
+	return g
 }
 
-func slideNorth(grid Grid) Grid {
-	for x := range grid[0] {
-		last := len(grid)
+func slideNorth(g Grid) Grid {
+	for x := 0; x < g.Width(); x++ {
+		last := g.Height()
 		for y := 0; y < last; y++ {
-			switch grid[y][x] {
+			switch g.At(grid.Point{X: x, Y: y}) {
 			case Squared:
 				last = y
 			case Round:
@@ -183,7 +99,7 @@ func slideNorth(grid Grid) Grid {
 				other := y
 				for ; other > 0; other-- {
 					next := other - 1
-					if next < 0 || grid[next][x] != Floor {
+					if next < 0 || g.At(grid.Point{X: x, Y: next}) != Floor {
 						break
 					}
 				}
@@ -191,75 +107,79 @@ func slideNorth(grid Grid) Grid {
 					continue
 				}
 				// move boulder to front
-				grid[y][x], grid[other][x] = grid[other][x], grid[y][x] // swap floor and boulder
+				a, b := grid.Point{X: x, Y: y}, grid.Point{X: x, Y: other}
+				va, vb := g.At(a), g.At(b)
+				g.Set(a, vb)
+				g.Set(b, va) // swap floor and boulder
 			case Floor:
 			}
 		}
 	}
-	return grid
+	return g
 }
 
-func getLastY(grid Grid, x int, b byte) int {
-	for i := len(grid) - 1; i >= 0; i-- {
-		if grid[i][x] != b {
+func getLastY(g Grid, x int, b byte) int {
+	for i := g.Height() - 1; i >= 0; i-- {
+		if g.At(grid.Point{X: x, Y: i}) != b {
 			return i + 1
 		}
 	}
 	return 0
 }
 
-func getLast(grid []byte, b byte) int {
-	for i := 0; i < len(grid); i++ {
-		if grid[i] != b {
+func getLast(row []byte, b byte) int {
+	for i := 0; i < len(row); i++ {
+		if row[i] != b {
 			return i - 1
 		}
 	}
-	return len(grid) - 1
+	return len(row) - 1
 }
 
-func countLoad(grid Grid) int {
-	size := len(grid)
+func countLoad(g Grid) int {
+	size := g.Height()
 	count := 0
-	for y, row := range grid {
-		for _, b := range row {
-			if b == Round {
-				count += size - y
-			}
+	g.Each(func(p grid.Point, b byte) {
+		if b == Round {
+			count += size - p.Y
 		}
-	}
+	})
 	return count
 }
 
 func part1(input Input) string {
 
-	grid := Grid(input)
-
-	grid = slideBoulders(grid, North)
+	g := slideBoulders(input, geom.North)
 
-	return aoc.Result(countLoad(grid))
+	return aoc.Result(countLoad(g))
 }
 
-func cycle(grid Grid) Grid {
+func cycle(g Grid) Grid {
 	for i := 0; i < 4; i++ {
-		grid = flip90(grid)
-		grid = slideBouldersEast(grid)
+		g = g.RotateRight()
+		g = slideBouldersEast(g)
 	}
-	return grid
+	return g
 }
 
-func part2(input Input) string {
+func part2(ctx context.Context, input Input) (string, error) {
 
 	const limit = 1_000_000_000
 
-	m := make(map[string]Grid)
-	last := make(map[string]int)
+	m := make(map[uint64]Grid)
+	last := make(map[uint64]int)
 
-	prev := Grid(input).String()
-	var curr = Grid(input)
+	prev := input.Hash()
+	var curr = input
 
-	for i := 0; i < 1_000_000_000; i++ {
+	log := aoc.Logger(ctx)
+
+	for i := 0; i < limit; i++ {
 		if i%1_000_000 == 0 {
-			log.Printf("%d/%d (%.2f)", i, limit, float64(i)/float64(limit)*100)
+			if ctx.Err() != nil {
+				return "", ctx.Err()
+			}
+			log.Info("Cycling", slog.Int("Cycle", i), slog.Int("Limit", limit))
 		}
 
 		// Detect repeating pattern
@@ -275,7 +195,7 @@ func part2(input Input) string {
 				curr = cycle(curr)
 			}
 
-			return aoc.Result(countLoad(curr))
+			return aoc.Result(countLoad(curr)), nil
 		}
 
 		last[prev] = i
@@ -287,9 +207,9 @@ func part2(input Input) string {
 
 		curr = cycle(curr)
 		m[prev] = curr
-		prev = curr.String()
+		prev = curr.Hash()
 	}
 
 	// this'll only happen if the pattern is larger than 1_000_000_000
-	return aoc.Result(countLoad(curr))
+	return aoc.Result(countLoad(curr)), nil
 }
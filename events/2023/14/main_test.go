@@ -1,6 +1,7 @@
-package main
+package day14
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"reflect"
@@ -8,6 +9,8 @@ import (
 	"testing"
 
 	"github.com/pimvanhespen/advent-of-code/pkg/aoc"
+	"github.com/pimvanhespen/advent-of-code/pkg/geom"
+	"github.com/pimvanhespen/advent-of-code/pkg/grid"
 )
 
 // exampleInput form the puzzle
@@ -90,108 +93,12 @@ func Test_part2(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := part2(tt.input); !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("part2() = %s, want %s", got, tt.want)
-			}
-		})
-	}
-}
-
-func Test_flip270(t *testing.T) {
-	type args struct {
-		grid Grid
-	}
-	tests := []struct {
-		name string
-		args args
-		want Grid
-	}{
-		{
-			name: "example",
-			args: args{
-				grid: Grid{
-					[]byte("...."),
-					[]byte("####"),
-				},
-			},
-			want: Grid{
-				[]byte(".#"),
-				[]byte(".#"),
-				[]byte(".#"),
-				[]byte(".#"),
-			},
-		},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			if got := flip270(tt.args.grid); !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("flip270() = %v, want %v", got, tt.want)
-			}
-		})
-	}
-}
-
-func Test_flip180(t *testing.T) {
-	type args struct {
-		grid Grid
-	}
-	tests := []struct {
-		name string
-		args args
-		want Grid
-	}{
-		{
-			name: "example",
-			args: args{
-				grid: Grid{
-					[]byte("...."),
-					[]byte("####"),
-				},
-			},
-			want: Grid{
-				[]byte("####"),
-				[]byte("...."),
-			},
-		},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			if got := flip180(tt.args.grid); !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("flip180() = %v, want %v", got, tt.want)
+			got, err := part2(context.Background(), tt.input)
+			if err != nil {
+				t.Fatal(err)
 			}
-		})
-	}
-}
-
-func Test_flip90(t *testing.T) {
-	type args struct {
-		grid Grid
-	}
-	tests := []struct {
-		name string
-		args args
-		want Grid
-	}{
-		{
-			name: "example",
-			args: args{
-				grid: Grid{
-					[]byte("...."),
-					[]byte("####"),
-				},
-			},
-			want: Grid{
-				[]byte("#."),
-				[]byte("#."),
-				[]byte("#."),
-				[]byte("#."),
-			},
-		},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			if got := flip90(tt.args.grid); !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("flip90() = %v, want %v", got, tt.want)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("part2() = %s, want %s", got, tt.want)
 			}
 		})
 	}
@@ -233,28 +140,24 @@ var cycleExamples = []string{
 
 func Test_cycle(t *testing.T) {
 
-	start, _ := parse(strings.NewReader(cycleExamples[0]))
-
-	grid := Grid(start)
+	g, _ := parse(strings.NewReader(cycleExamples[0]))
 
 	for i := 1; i < len(cycleExamples); i++ {
-		grid = cycle(grid)
-		w, _ := parse(strings.NewReader(cycleExamples[i]))
-		want := Grid(w)
-		if !grid.Equal(want) {
-			t.Errorf("cycle() = mismatch:\n%s", gridComparison(grid, want))
+		g = cycle(g)
+		want, _ := parse(strings.NewReader(cycleExamples[i]))
+		if !grid.Equal(g, want) {
+			t.Errorf("cycle() = mismatch:\n%s", gridComparison(g, want))
 		}
 	}
 }
 
 func Test_slideNorth(t *testing.T) {
 	for _, s := range cycleExamples {
-		parsed, _ := parse(strings.NewReader(s))
-		grid := Grid(parsed)
-		got := slideNorth(grid)
-		want := slideBoulders(grid, North)
+		g, _ := parse(strings.NewReader(s))
+		got := slideNorth(g)
+		want := slideBoulders(g, geom.North)
 
-		if !got.Equal(want) {
+		if !grid.Equal(got, want) {
 			t.Errorf("slideNorth() = mismatch:\n%s", gridComparison(got, want))
 		}
 	}
@@ -262,40 +165,31 @@ func Test_slideNorth(t *testing.T) {
 
 func gridComparison(got, want Grid) string {
 	var sb strings.Builder
-	format := fmt.Sprintf("%%-%ds %%-%ds\n", len(got[0]), len(want[0]))
+	format := fmt.Sprintf("%%-%ds %%-%ds\n", got.Width(), want.Width())
 	_, _ = fmt.Fprintf(&sb, format, "got", "want")
 
-	if len(got) != len(want) {
-		_, _ = fmt.Fprintf(&sb, "len(got)=%d len(want)=%d\n", len(got), len(want))
+	if got.Height() != want.Height() {
+		_, _ = fmt.Fprintf(&sb, "got.Height()=%d want.Height()=%d\n", got.Height(), want.Height())
 	}
 
-	if len(got[0]) != len(want[0]) {
-		_, _ = fmt.Fprintf(&sb, "len(got[0])=%d len(want[0])=%d\n", len(got[0]), len(want[0]))
+	if got.Width() != want.Width() {
+		_, _ = fmt.Fprintf(&sb, "got.Width()=%d want.Width()=%d\n", got.Width(), want.Width())
 	}
 
-	for y := 0; y < len(got); y++ {
-
-		for x := 0; x < len(got[y]); x++ {
-
-		}
-
-		if y < len(got) {
-			sb.WriteString(string(got[y]))
-		} else {
-			sb.WriteString(strings.Repeat(" ", len(got[0])))
-		}
+	for y := 0; y < got.Height(); y++ {
+		sb.Write(got.Row(y))
 
 		sb.WriteString(" ")
 
-		if y < len(want) {
-			sb.WriteString(string(want[y]))
+		if y < want.Height() {
+			sb.Write(want.Row(y))
 		} else {
-			sb.WriteString(strings.Repeat(" ", len(want[0])))
+			sb.WriteString(strings.Repeat(" ", want.Width()))
 		}
 
-		for x := 0; x < min(len(got[0]), len(want[0])); x++ {
-			if got[y][x] != want[y][x] {
-				_, _ = fmt.Fprintf(&sb, " %d:%c:%c", x, got[y][x], want[y][x])
+		for x := 0; x < min(got.Width(), want.Width()) && y < want.Height(); x++ {
+			if got.Row(y)[x] != want.Row(y)[x] {
+				_, _ = fmt.Fprintf(&sb, " %d:%c:%c", x, got.Row(y)[x], want.Row(y)[x])
 			}
 		}
 
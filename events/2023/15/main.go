@@ -1,19 +1,18 @@
-package main
+package day15
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"github.com/pimvanhespen/advent-of-code/pkg/aoc"
 	"io"
-	"log"
+	"log/slog"
 )
 
 type Input [][]byte
 
-func main() {
-	event := aoc.New(2023, 15, parse)
-	fmt.Println("1:", aoc.Must(event.Run(part1)))
-	fmt.Println("2:", aoc.Must(event.Run(part2)))
+func init() {
+	aoc.RegisterContext(2023, 15, parse, aoc.SolverFunc[Input](part1).Context(), part2)
 }
 
 func parse(r io.Reader) (Input, error) {
@@ -71,7 +70,7 @@ func (b *Box) Add(l Lens) {
 	b.Lenses = append(b.Lenses, l)
 }
 
-func part2(input Input) string {
+func part2(ctx context.Context, input Input) (string, error) {
 
 	var boxes [256]Box
 
@@ -87,18 +86,20 @@ func part2(input Input) string {
 			boxes[hash(label)].Add(Lens{label, last - '0'})
 
 		default:
-			log.Fatalf("bad input: %s", b)
+			return "", fmt.Errorf("bad step: %s", b)
 		}
 	}
 
+	log := aoc.Logger(ctx)
+
 	var sum uint64
 	for i, box := range boxes {
 		for slot, lens := range box.Lenses {
 			n := uint64(1+i) * uint64(1+slot) * uint64(lens.FocalLength)
-			log.Printf("%s: %d (box %d) * %d (slot %d) * %d (focal length) = %d", lens.Label, 1+i, i, 1+slot, slot, lens.FocalLength, n)
+			log.Debug("Focusing power", slog.String("Label", string(lens.Label)), slog.Int("Box", i), slog.Int("Slot", slot), slog.Int("FocalLength", int(lens.FocalLength)), slog.Uint64("Power", n))
 			sum += n
 		}
 	}
 
-	return aoc.Result(sum)
+	return aoc.Result(sum), nil
 }
@@ -1,6 +1,7 @@
-package main
+package day15
 
 import (
+	"context"
 	"reflect"
 	"strings"
 	"testing"
@@ -78,7 +79,11 @@ func Test_part2(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := part2(tt.input); !reflect.DeepEqual(got, tt.want) {
+			got, err := part2(context.Background(), tt.input)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("part2() = %s, want %s", got, tt.want)
 			}
 		})
@@ -1,18 +1,16 @@
-package main
+package day16
 
 import (
 	"bytes"
-	"fmt"
 	"github.com/pimvanhespen/advent-of-code/pkg/aoc"
+	"github.com/pimvanhespen/advent-of-code/pkg/geom"
 	"io"
 )
 
 type Input [][]byte
 
-func main() {
-	event := aoc.New(2023, 16, parse)
-	fmt.Println("1:", aoc.Must(event.Run(part1)))
-	fmt.Println("2:", aoc.Must(event.Run(part2)))
+func init() {
+	aoc.Register(2023, 16, parse, part1, part2)
 }
 
 func parse(r io.Reader) (Input, error) {
@@ -27,22 +25,13 @@ func parse(r io.Reader) (Input, error) {
 }
 
 var (
-	Up    = Vec2{0, -1}
-	Down  = Vec2{0, 1}
-	Left  = Vec2{-1, 0}
-	Right = Vec2{1, 0}
+	Up    = geom.North.Vec()
+	Down  = geom.South.Vec()
+	Left  = geom.West.Vec()
+	Right = geom.East.Vec()
 )
 
-type Vec2 struct {
-	X, Y int
-}
-
-func (v Vec2) Add(o Vec2) Vec2 {
-	return Vec2{
-		X: v.X + o.X,
-		Y: v.Y + o.Y,
-	}
-}
+type Vec2 = geom.Vec2[int]
 
 type Beam struct {
 	Pos Vec2
@@ -129,21 +118,21 @@ func Energize(grid [][]byte, initial Beam) int {
 }
 
 func part1(input Input) string {
-	total := Energize(input, Beam{Pos: Vec2{-1, 0}, Dir: Right})
+	total := Energize(input, Beam{Pos: Vec2{X: -1}, Dir: Right})
 	return aoc.Result(total)
 }
 
 func part2(input Input) string {
 	var total int
 	for y := range input {
-		left := Energize(input, Beam{Pos: Vec2{-1, y}, Dir: Right})
-		right := Energize(input, Beam{Pos: Vec2{len(input[0]), y}, Dir: Left})
+		left := Energize(input, Beam{Pos: Vec2{X: -1, Y: y}, Dir: Right})
+		right := Energize(input, Beam{Pos: Vec2{X: len(input[0]), Y: y}, Dir: Left})
 		total = max(total, left, right)
 	}
 
 	for x := range input[0] {
-		top := Energize(input, Beam{Pos: Vec2{x, -1}, Dir: Down})
-		bottom := Energize(input, Beam{Pos: Vec2{x, len(input)}, Dir: Up})
+		top := Energize(input, Beam{Pos: Vec2{X: x, Y: -1}, Dir: Down})
+		bottom := Energize(input, Beam{Pos: Vec2{X: x, Y: len(input)}, Dir: Up})
 		total = max(total, top, bottom)
 	}
 
@@ -1,4 +1,4 @@
-package main
+package day16
 
 import (
 	"github.com/pimvanhespen/advent-of-code/pkg/aoc"
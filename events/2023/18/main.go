@@ -1,30 +1,26 @@
-package main
+package day18
 
 import (
 	"bytes"
 	"fmt"
-	"image"
 	"io"
-	"log"
-	"slices"
 	"strconv"
 	"strings"
 
 	"github.com/pimvanhespen/advent-of-code/pkg/aoc"
+	"github.com/pimvanhespen/advent-of-code/pkg/geom"
 )
 
 type Step struct {
-	Dir   image.Point
+	Dir   geom.Dir
 	Len   int
 	Color string
 }
 
 type Input []Step
 
-func main() {
-	event := aoc.New(2023, 18, parse)
-	fmt.Println("1:", aoc.Must(event.Run(part1)))
-	fmt.Println("2:", aoc.Must(event.Run(part2)))
+func init() {
+	aoc.Register(2023, 18, parse, part1, part2)
 }
 
 func parse(r io.Reader) (Input, error) {
@@ -33,16 +29,9 @@ func parse(r io.Reader) (Input, error) {
 			return Step{}, aoc.IgnoreLine
 		}
 		parts := strings.Split(s, " ")
-		var dir image.Point
-		switch parts[0] {
-		case "R":
-			dir = image.Pt(1, 0)
-		case "L":
-			dir = image.Pt(-1, 0)
-		case "U":
-			dir = image.Pt(0, 1)
-		case "D":
-			dir = image.Pt(0, -1)
+		dir, err := geom.ParseDir(parts[0])
+		if err != nil {
+			return Step{}, err
 		}
 		n, err := strconv.Atoi(parts[1])
 		if err != nil {
@@ -56,7 +45,7 @@ func parse(r io.Reader) (Input, error) {
 	})
 }
 
-func flashReplace(grid [][]byte, starts image.Point, from, to byte) {
+func flashReplace(grid [][]byte, starts geom.Vec2[int], from, to byte) {
 
 	if grid[starts.Y][starts.X] != from {
 		return
@@ -65,13 +54,8 @@ func flashReplace(grid [][]byte, starts image.Point, from, to byte) {
 	grid[starts.Y][starts.X] = to
 
 	// floodfill
-	for _, dir := range []image.Point{
-		image.Pt(1, 0),
-		image.Pt(-1, 0),
-		image.Pt(0, 1),
-		image.Pt(0, -1),
-	} {
-		next := starts.Add(dir)
+	for _, dir := range geom.Cardinals {
+		next := starts.Move(dir, 1)
 		if next.X < 0 || next.X >= len(grid[0]) || next.Y < 0 || next.Y >= len(grid) {
 			continue
 		}
@@ -80,22 +64,16 @@ func flashReplace(grid [][]byte, starts image.Point, from, to byte) {
 }
 
 func solve(input Input) int {
-	pts := make([]image.Point, 0, len(input))
-	curr := image.Pt(0, 0)
-	var perimeter int
-	//pts = append(pts, curr)
+	pts := make([]geom.Vec2[int], 0, len(input))
+	var curr geom.Vec2[int]
 	for _, step := range input {
-		perimeter += step.Len
-		addition := step.Dir.Mul(step.Len)
-		next := curr.Add(addition)
-		log.Printf("%v + %v = %v", curr, addition, next)
+		next := curr.Move(step.Dir, step.Len)
 		pts = append(pts, next)
 		curr = next
 	}
 
-	area := Area(pts) / 2
-
-	return area + perimeter/2 + 1
+	// the trench is dug around the cells of the vertices
+	return geom.LatticePoints(pts)
 }
 
 func part1(input Input) string {
@@ -105,16 +83,16 @@ func part1(input Input) string {
 func solveFill(input Input) int {
 	minX, minY, maxX, maxY := 0, 0, 0, 0
 
-	curr := image.Pt(0, 0)
+	curr := geom.V2(0, 0)
 
-	m := make(map[image.Point]bool)
+	m := make(map[geom.Vec2[int]]bool)
 	m[curr] = true
 
 	for _, step := range input {
-		next := curr.Add(step.Dir.Mul(step.Len))
+		next := curr.Move(step.Dir, step.Len)
 
 		for i := 1; i <= step.Len; i++ {
-			m[curr.Add(step.Dir.Mul(i))] = true
+			m[curr.Move(step.Dir, i)] = true
 		}
 
 		minX = min(minX, next.X)
@@ -134,7 +112,7 @@ func solveFill(input Input) int {
 
 	for y := range grid {
 		for x := range grid[y] {
-			if m[image.Pt(x+minX, y+minY)] {
+			if m[geom.V2(x+minX, y+minY)] {
 				grid[y][x] = '#'
 			} else {
 				grid[y][x] = '.'
@@ -164,77 +142,32 @@ func solveFill(input Input) int {
 	return len(grid)*len(grid[0]) - floors
 }
 
-func outerring(w, h int) []image.Point {
-	var points []image.Point
+func outerring(w, h int) []geom.Vec2[int] {
+	var points []geom.Vec2[int]
 	for x := 0; x < w; x++ {
-		points = append(points, image.Pt(x, 0))
-		points = append(points, image.Pt(x, h-1))
+		points = append(points, geom.V2(x, 0))
+		points = append(points, geom.V2(x, h-1))
 	}
 	for y := 1; y < h-1; y++ {
-		points = append(points, image.Pt(0, y))
-		points = append(points, image.Pt(w-1, y))
+		points = append(points, geom.V2(0, y))
+		points = append(points, geom.V2(w-1, y))
 	}
 	return points
 }
 
-func Picks(points []image.Point) int {
-
-	var perimeter int
-	var curr, next image.Point
-	for i := range points {
-		next = points[(i+1)%len(points)]
-		perimeter += diff(next, curr)
-		curr = next
-	}
-
-	A := Area(points) / 2
-
-	return A + perimeter/2 + 1
-}
-
-func diff(a, b image.Point) int {
-	dx, dy := a.X-b.X, a.Y-b.Y
-	return abs(dx) + abs(dy)
-}
-
-func abs(a int) int {
-	if a < 0 {
-		return -a
-	}
-	return a
-}
-
-// Area calculates the area inside the polygon https://en.wikipedia.org/wiki/Shoelace_formula
-func Area(points []image.Point) int {
-
-	var sum int
-	for i := range points {
-		a, b := points[i], points[(i+1)%len(points)]
-		diff := a.X*b.Y - a.Y*b.X
-		sum += diff
-	}
-
-	if sum < 0 {
-		slices.Reverse(points)
-		return Area(points)
-	}
-
-	return sum
-}
-
 func parseStep(s string) Step {
 	s = strings.TrimPrefix(s, "#")
 
-	var dir image.Point
+	var dir geom.Dir
 	switch s[len(s)-1] {
 	case '0':
-		dir = image.Pt(1, 0)
+		dir = geom.East
 	case '1':
-		dir = image.Pt(0, -1)
+		dir = geom.South
 	case '2':
-		dir = image.Pt(-1, 0)
+		dir = geom.West
 	case '3':
-		dir = image.Pt(0, 1)
+		dir = geom.North
 	default:
 		panic("invalid dir")
 	}
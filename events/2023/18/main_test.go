@@ -1,13 +1,13 @@
-package main
+package day18
 
 import (
-	"image"
 	"io"
 	"reflect"
 	"strings"
 	"testing"
 
 	"github.com/pimvanhespen/advent-of-code/pkg/aoc"
+	"github.com/pimvanhespen/advent-of-code/pkg/geom"
 )
 
 // exampleInput form the puzzle
@@ -42,20 +42,20 @@ func Test_parse(t *testing.T) {
 				r: strings.NewReader(exampleInput),
 			},
 			want: Input{
-				Step{Dir: image.Pt(1, 0), Len: 6, Color: "#70c710"},
-				Step{Dir: image.Pt(0, -1), Len: 5, Color: "#0dc571"},
-				Step{Dir: image.Pt(-1, 0), Len: 2, Color: "#5713f0"},
-				Step{Dir: image.Pt(0, -1), Len: 2, Color: "#d2c081"},
-				Step{Dir: image.Pt(1, 0), Len: 2, Color: "#59c680"},
-				Step{Dir: image.Pt(0, -1), Len: 2, Color: "#411b91"},
-				Step{Dir: image.Pt(-1, 0), Len: 5, Color: "#8ceee2"},
-				Step{Dir: image.Pt(0, 1), Len: 2, Color: "#caa173"},
-				Step{Dir: image.Pt(-1, 0), Len: 1, Color: "#1b58a2"},
-				Step{Dir: image.Pt(0, 1), Len: 2, Color: "#caa171"},
-				Step{Dir: image.Pt(1, 0), Len: 2, Color: "#7807d2"},
-				Step{Dir: image.Pt(0, 1), Len: 3, Color: "#a77fa3"},
-				Step{Dir: image.Pt(-1, 0), Len: 2, Color: "#015232"},
-				Step{Dir: image.Pt(0, 1), Len: 2, Color: "#7a21e3"},
+				Step{Dir: geom.East, Len: 6, Color: "#70c710"},
+				Step{Dir: geom.South, Len: 5, Color: "#0dc571"},
+				Step{Dir: geom.West, Len: 2, Color: "#5713f0"},
+				Step{Dir: geom.South, Len: 2, Color: "#d2c081"},
+				Step{Dir: geom.East, Len: 2, Color: "#59c680"},
+				Step{Dir: geom.South, Len: 2, Color: "#411b91"},
+				Step{Dir: geom.West, Len: 5, Color: "#8ceee2"},
+				Step{Dir: geom.North, Len: 2, Color: "#caa173"},
+				Step{Dir: geom.West, Len: 1, Color: "#1b58a2"},
+				Step{Dir: geom.North, Len: 2, Color: "#caa171"},
+				Step{Dir: geom.East, Len: 2, Color: "#7807d2"},
+				Step{Dir: geom.North, Len: 3, Color: "#a77fa3"},
+				Step{Dir: geom.West, Len: 2, Color: "#015232"},
+				Step{Dir: geom.North, Len: 2, Color: "#7a21e3"},
 			},
 		},
 	}
@@ -129,7 +129,7 @@ func Test_part2(t *testing.T) {
 
 func Test_shoelace(t *testing.T) {
 	type args struct {
-		points []image.Point
+		points []geom.Vec2[int]
 	}
 	tests := []struct {
 		name string
@@ -139,12 +139,12 @@ func Test_shoelace(t *testing.T) {
 		{
 			name: "Wiki example",
 			args: args{
-				points: []image.Point{
-					image.Pt(1, 6),
-					image.Pt(3, 1),
-					image.Pt(7, 2),
-					image.Pt(4, 4),
-					image.Pt(8, 5),
+				points: []geom.Vec2[int]{
+					geom.V2(1, 6),
+					geom.V2(3, 1),
+					geom.V2(7, 2),
+					geom.V2(4, 4),
+					geom.V2(8, 5),
 				},
 			},
 			want: 33,
@@ -152,12 +152,12 @@ func Test_shoelace(t *testing.T) {
 		{
 			name: "Wiki example",
 			args: args{
-				points: []image.Point{
-					image.Pt(1-4, 6-4),
-					image.Pt(3-4, 1-4),
-					image.Pt(7-4, 2-4),
-					image.Pt(4-4, 4-4),
-					image.Pt(8-4, 5-4),
+				points: []geom.Vec2[int]{
+					geom.V2(1-4, 6-4),
+					geom.V2(3-4, 1-4),
+					geom.V2(7-4, 2-4),
+					geom.V2(4-4, 4-4),
+					geom.V2(8-4, 5-4),
 				},
 			},
 			want: 33,
@@ -165,11 +165,11 @@ func Test_shoelace(t *testing.T) {
 		{
 			name: "Demo Square",
 			args: args{
-				points: []image.Point{
-					image.Pt(0, 0),
-					image.Pt(10, 0),
-					image.Pt(10, 10),
-					image.Pt(0, 10),
+				points: []geom.Vec2[int]{
+					geom.V2(0, 0),
+					geom.V2(10, 0),
+					geom.V2(10, 10),
+					geom.V2(0, 10),
 				},
 			},
 			want: 2 * 10 * 10,
@@ -177,12 +177,12 @@ func Test_shoelace(t *testing.T) {
 		{
 			name: "Demo Wiki2",
 			args: args{
-				points: []image.Point{
-					image.Pt(3, 1),
-					image.Pt(7, 2),
-					image.Pt(4, 4),
-					image.Pt(8, 6),
-					image.Pt(1, 7),
+				points: []geom.Vec2[int]{
+					geom.V2(3, 1),
+					geom.V2(7, 2),
+					geom.V2(4, 4),
+					geom.V2(8, 6),
+					geom.V2(1, 7),
 				},
 			},
 			want: 41,
@@ -190,8 +190,8 @@ func Test_shoelace(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := Area(tt.args.points); got != tt.want {
-				t.Errorf("Area() = %v, want %v", got, tt.want)
+			if got := geom.DoubleArea(tt.args.points); got != tt.want {
+				t.Errorf("DoubleArea() = %v, want %v", got, tt.want)
 			}
 		})
 	}
@@ -212,7 +212,7 @@ func Test_parseStep(t *testing.T) {
 				s: "#70c710",
 			},
 			want: Step{
-				Dir: image.Pt(1, 0),
+				Dir: geom.East,
 				Len: 461937,
 			},
 		},
@@ -0,0 +1,132 @@
+package aoc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+var ErrNoAnswer = errors.New("no answer")
+
+// Screen is an answer drawn in pixels, such as the display of 2016/08.
+// Screens are read with OCR when their answer is formatted.
+type Screen interface {
+	Size() (width, height int)
+	Lit(x, y int) bool
+}
+
+// OCRFunc reads the text drawn on a screen.
+type OCRFunc func(Screen) (string, error)
+
+// FormatAnswer normalizes an answer to the text that is submitted. Screens
+// are read with ocr, ReadLetters when nil. Stringers are formatted with their
+// String method, numbers in decimal notation and anything else with %v.
+// Surrounding white space is removed and an empty answer is an error.
+func FormatAnswer(v any, ocr OCRFunc) (string, error) {
+	if ocr == nil {
+		ocr = ReadLetters
+	}
+
+	var s string
+
+	switch a := v.(type) {
+	case nil:
+		return "", ErrNoAnswer
+	case Screen:
+		var err error
+		if s, err = ocr(a); err != nil {
+			return "", err
+		}
+	case string:
+		s = a
+	case []byte:
+		s = string(a)
+	case fmt.Stringer:
+		s = a.String()
+	default:
+		s = formatValue(reflect.ValueOf(v))
+	}
+
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return "", ErrNoAnswer
+	}
+
+	return s, nil
+}
+
+func formatValue(v reflect.Value) string {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(v.Uint(), 10)
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', -1, 64)
+	case reflect.Pointer:
+		if v.IsNil() {
+			return ""
+		}
+		return formatValue(v.Elem())
+	default:
+		return fmt.Sprintf("%v", v.Interface())
+	}
+}
+
+// Result formats an answer with FormatAnswer, falling back to %v.
+func Result(v any) string {
+	s, err := FormatAnswer(v, nil)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	return s
+}
+
+type ocrKey struct{}
+
+func withOCR(ctx context.Context, ocr OCRFunc) context.Context {
+	return context.WithValue(ctx, ocrKey{}, ocr)
+}
+
+func ocrFrom(ctx context.Context) OCRFunc {
+	ocr, _ := ctx.Value(ocrKey{}).(OCRFunc)
+	return ocr
+}
+
+// Typed adapts a solver with a typed answer, which is formatted with
+// FormatAnswer using the OCR of the runner.
+func Typed[Input, T any](solve func(Input) (T, error)) ContextSolverFunc[Input] {
+	return TypedContext(func(_ context.Context, input Input) (T, error) {
+		return solve(input)
+	})
+}
+
+// Answer adapts a solver with a typed answer that cannot fail, like the
+// solvers of 2015 that return an int. The answer is formatted with Result.
+func Answer[Input, T any](solve func(Input) T) SolverFunc[Input] {
+	return func(input Input) string {
+		return Result(solve(input))
+	}
+}
+
+// TypedContext is Typed for solvers that take a context.
+func TypedContext[Input, T any](solve func(context.Context, Input) (T, error)) ContextSolverFunc[Input] {
+	return func(ctx context.Context, input Input) (string, error) {
+		answer, err := solve(ctx, input)
+		if err != nil {
+			return "", err
+		}
+		return FormatAnswer(answer, ocrFrom(ctx))
+	}
+}
+
+// WithOCR reads screen answers of typed solvers with ocr instead of
+// ReadLetters.
+func WithOCR(ocr OCRFunc) Option {
+	return func(o *options) {
+		o.ocr = ocr
+	}
+}
@@ -0,0 +1,87 @@
+package aoc
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestFormatAnswer(t *testing.T) {
+	type count int
+
+	var nilInt *int
+	seven := 7
+
+	tests := []struct {
+		name    string
+		v       any
+		ocr     OCRFunc
+		want    string
+		wantErr error
+	}{
+		{name: "int", v: 42, want: "42"},
+		{name: "named int", v: count(-3), want: "-3"},
+		{name: "uint64", v: uint64(math.MaxUint64), want: "18446744073709551615"},
+		{name: "float", v: 12.0, want: "12"},
+		{name: "pointer", v: &seven, want: "7"},
+		{name: "big", v: new(big.Int).Lsh(big.NewInt(1), 70), want: "1180591620717411303424"},
+		{name: "string", v: "\n abc \n", want: "abc"},
+		{name: "bytes", v: []byte("xyz"), want: "xyz"},
+		{name: "screen", v: pixels{".##..", "#..#.", "#..#.", "####.", "#..#.", "#..#."}, want: "A"},
+		{
+			name: "ocr",
+			v:    pixels{"#"},
+			ocr:  func(Screen) (string, error) { return "custom", nil },
+			want: "custom",
+		},
+		{name: "nil", v: nil, wantErr: ErrNoAnswer},
+		{name: "nil pointer", v: nilInt, wantErr: ErrNoAnswer},
+		{name: "empty", v: " ", wantErr: ErrNoAnswer},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FormatAnswer(tt.v, tt.ocr)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("FormatAnswer() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("FormatAnswer() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTyped(t *testing.T) {
+	solve := Typed(func(input int) (uint64, error) {
+		return uint64(input) * 2, nil
+	})
+
+	got, err := solve(context.Background(), 21)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != "42" {
+		t.Errorf("Typed() = %q, want %q", got, "42")
+	}
+
+	ctx := withOCR(context.Background(), func(Screen) (string, error) { return "OCR", nil })
+	got, err = Typed(func(int) (Screen, error) { return pixels{"#"}, nil })(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != "OCR" {
+		t.Errorf("Typed() with OCR = %q, want %q", got, "OCR")
+	}
+}
+
+func TestAnswer(t *testing.T) {
+	solve := Answer(func(input []int) uint16 {
+		return uint16(len(input))
+	})
+
+	if got := solve([]int{1, 2, 3}); got != "3" {
+		t.Errorf("Answer() = %q, want %q", got, "3")
+	}
+}
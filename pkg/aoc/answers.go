@@ -0,0 +1,182 @@
+package aoc
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+)
+
+// Verdict is the outcome of comparing an answer to the known answer.
+type Verdict string
+
+const (
+	Pass    Verdict = "PASS"
+	Fail    Verdict = "FAIL"
+	Missing Verdict = "MISSING"
+	// Error is reported for parts whose solver failed to produce an answer.
+	Error Verdict = "ERROR"
+)
+
+// Answers holds the accepted answers of a challenge.
+// It is stored as answers.json next to input.txt.
+type Answers struct {
+	Part1 string `json:"part1,omitempty"`
+	Part2 string `json:"part2,omitempty"`
+	// Rejections holds the answers the site rejected, by part.
+	Rejections map[int][]Rejection `json:"rejected,omitempty"`
+}
+
+// Rejection is an answer that was rejected by the site.
+type Rejection struct {
+	Answer string       `json:"answer"`
+	Status SubmitStatus `json:"status"`
+}
+
+// Get returns the known answer of the given part.
+func (a Answers) Get(part int) (string, bool) {
+	switch part {
+	case 1:
+		return a.Part1, a.Part1 != ""
+	case 2:
+		return a.Part2, a.Part2 != ""
+	default:
+		return "", false
+	}
+}
+
+// Set records the answer of the given part.
+func (a *Answers) Set(part int, answer string) error {
+	switch part {
+	case 1:
+		a.Part1 = answer
+	case 2:
+		a.Part2 = answer
+	default:
+		return fmt.Errorf("part %d: %w", part, ErrNoPart)
+	}
+	return nil
+}
+
+// Reject records a rejected answer of the given part.
+func (a *Answers) Reject(part int, r Rejection) {
+	if a.Rejections == nil {
+		a.Rejections = make(map[int][]Rejection)
+	}
+	a.Rejections[part] = append(a.Rejections[part], r)
+}
+
+// Rejected reports whether answer is known to be wrong for the given part.
+// Numeric answers are also rejected when they lie beyond an answer that was
+// rejected for being too high or too low.
+func (a Answers) Rejected(part int, answer string) (Rejection, bool) {
+	n, nerr := strconv.ParseInt(answer, 10, 64)
+
+	for _, r := range a.Rejections[part] {
+		if r.Answer == answer {
+			return r, true
+		}
+
+		if nerr != nil {
+			continue
+		}
+
+		bound, err := strconv.ParseInt(r.Answer, 10, 64)
+		if err != nil {
+			continue
+		}
+
+		if (r.Status == TooHigh && n >= bound) || (r.Status == TooLow && n <= bound) {
+			return r, true
+		}
+	}
+
+	return Rejection{}, false
+}
+
+// Verify compares answer to the known answer of the given part.
+func (a Answers) Verify(part int, answer string) Verdict {
+	want, ok := a.Get(part)
+	switch {
+	case !ok:
+		return Missing
+	case want == answer:
+		return Pass
+	default:
+		return Fail
+	}
+}
+
+func problemAnswers(year, day int) (string, error) {
+	dir, err := problemDir(year, day)
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dir, "answers.json"), nil
+}
+
+// Answers reads the known answers of the challenge.
+// A challenge without answers.json has no known answers.
+func (p Challenge) Answers() (Answers, error) {
+	fp, err := problemAnswers(p.Year, p.Day)
+	if err != nil {
+		return Answers{}, err
+	}
+
+	b, err := os.ReadFile(fp)
+	if errors.Is(err, fs.ErrNotExist) {
+		return Answers{}, nil
+	}
+	if err != nil {
+		return Answers{}, err
+	}
+
+	var answers Answers
+	if err = json.Unmarshal(b, &answers); err != nil {
+		return Answers{}, fmt.Errorf("decode %s: %w", fp, err)
+	}
+
+	return answers, nil
+}
+
+// StoreAnswer records answer as the accepted answer of the given part.
+func (p Challenge) StoreAnswer(part int, answer string) error {
+	return p.updateAnswers(func(answers *Answers) error {
+		return answers.Set(part, answer)
+	})
+}
+
+// StoreRejection records an answer that was rejected for the given part.
+func (p Challenge) StoreRejection(part int, r Rejection) error {
+	return p.updateAnswers(func(answers *Answers) error {
+		answers.Reject(part, r)
+		return nil
+	})
+}
+
+func (p Challenge) updateAnswers(update func(*Answers) error) error {
+	answers, err := p.Answers()
+	if err != nil {
+		return err
+	}
+
+	if err = update(&answers); err != nil {
+		return err
+	}
+
+	fp, err := problemAnswers(p.Year, p.Day)
+	if err != nil {
+		return err
+	}
+
+	b, err := json.MarshalIndent(answers, "", "\t")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(fp, append(b, '\n'), 0644)
+}
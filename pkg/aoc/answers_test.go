@@ -0,0 +1,57 @@
+package aoc
+
+import "testing"
+
+func TestAnswers_Verify(t *testing.T) {
+	answers := Answers{Part1: "42"}
+
+	tests := []struct {
+		name   string
+		part   int
+		answer string
+		want   Verdict
+	}{
+		{"pass", 1, "42", Pass},
+		{"fail", 1, "43", Fail},
+		{"missing", 2, "42", Missing},
+		{"unknown part", 3, "42", Missing},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := answers.Verify(tt.part, tt.answer); got != tt.want {
+				t.Errorf("Verify() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAnswers_Rejected(t *testing.T) {
+	var answers Answers
+	answers.Reject(1, Rejection{Answer: "100", Status: TooHigh})
+	answers.Reject(1, Rejection{Answer: "10", Status: TooLow})
+	answers.Reject(1, Rejection{Answer: "abc", Status: Incorrect})
+
+	tests := []struct {
+		answer string
+		want   bool
+	}{
+		{"100", true},
+		{"150", true},
+		{"10", true},
+		{"5", true},
+		{"50", false},
+		{"abc", true},
+		{"abd", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.answer, func(t *testing.T) {
+			if _, got := answers.Rejected(1, tt.answer); got != tt.want {
+				t.Errorf("Rejected() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if _, got := answers.Rejected(2, "100"); got {
+		t.Errorf("Rejected() part 2 = %v, want false", got)
+	}
+}
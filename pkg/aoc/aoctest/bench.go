@@ -0,0 +1,77 @@
+// Package aoctest benchmarks the parsers and solvers of a day on its
+// input.txt, from the bench_test.go of the day.
+package aoctest
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/pimvanhespen/advent-of-code/pkg/aoc"
+)
+
+// input returns the normalized input.txt of a challenge and skips the
+// benchmark when it is missing. Inputs are never downloaded by benchmarks.
+func input(b *testing.B, year, day int) []byte {
+	b.Helper()
+
+	r, err := aoc.NewChallenge(year, day).NamedInput(aoc.DefaultInput)
+	if errors.Is(err, aoc.ErrNoInput) {
+		b.Skipf("%s: no input.txt", aoc.NewChallenge(year, day))
+	}
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	data, err := io.ReadAll(r)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	return data
+}
+
+// BenchmarkParse benchmarks the parser of a day on its input.txt.
+func BenchmarkParse[T any](b *testing.B, year, day int, parser aoc.ParserFunc[T]) {
+	data := input(b, year, day)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		if _, err := parser(bytes.NewReader(data)); err != nil {
+			b.Fatalf("parse: %v", err)
+		}
+	}
+}
+
+// BenchmarkSolve benchmarks a part of a day on its parsed input.txt.
+func BenchmarkSolve[T any](b *testing.B, year, day int, parser aoc.ParserFunc[T], solve aoc.SolverFunc[T]) {
+	BenchmarkSolveContext(b, year, day, parser, solve.Context())
+}
+
+// BenchmarkSolveContext is BenchmarkSolve for solvers that take a context.
+// Every iteration solves a freshly parsed input, as solvers may change their
+// input. Parsing is not timed.
+func BenchmarkSolveContext[T any](b *testing.B, year, day int, parser aoc.ParserFunc[T], solve aoc.ContextSolverFunc[T]) {
+	data := input(b, year, day)
+	ctx := context.Background()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		parsed, err := parser(bytes.NewReader(data))
+		if err != nil {
+			b.Fatalf("parse: %v", err)
+		}
+		b.StartTimer()
+
+		if _, err = solve(ctx, parsed); err != nil {
+			b.Fatalf("solve: %v", err)
+		}
+	}
+}
@@ -1,12 +1,11 @@
 package aoc
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
 	"io"
-	"net/http"
-	"net/url"
 	"os"
 	"path/filepath"
 	"time"
@@ -16,10 +15,6 @@ const (
 	baseURL = "https://adventofcode.com/"
 )
 
-func Result(v any) string {
-	return fmt.Sprintf("%v", v)
-}
-
 type Challenge struct {
 	Year int
 	Day  int
@@ -32,42 +27,61 @@ func NewChallenge(year, day int) Challenge {
 	}
 }
 
+// Input returns the normalized input of the challenge, downloading it when it
+// is missing unless offline mode is enabled.
 func (p Challenge) Input() (io.Reader, error) {
-	dir, err := problemDir(p.Year, p.Day)
+	return p.input(Offline())
+}
+
+func (p Challenge) input(offline bool) (io.Reader, error) {
+	input, err := problemInput(p.Year, p.Day)
 	if err != nil {
 		return nil, err
 	}
 
-	input := filepath.Join(dir, "input.txt")
+	if !existsFile(input) {
+		if offline {
+			return nil, fmt.Errorf("%s: %w", p, ErrOffline)
+		}
 
-	if existsFile(input) {
-		// already downloaded
-		reader := getReader(input)
-		return reader, nil
+		// download and save
+		if err = download(p.Year, p.Day); err != nil {
+			return nil, err
+		}
 	}
 
-	// download and save
-	err = download(p.Year, p.Day)
+	b, err := os.ReadFile(input)
 	if err != nil {
 		return nil, err
 	}
 
-	// return reader
-	reader := getReader(input)
-	return reader, nil
+	b = NormalizeInput(b)
+
+	if err = verifyChecksum(p.Year, p.Day, b); err != nil {
+		return nil, err
+	}
+
+	return bytes.NewReader(b), nil
 }
 
 func (p Challenge) String() string {
 	return fmt.Sprintf("%04d-%02d", p.Year, p.Day)
 }
 
+func (p Challenge) Less(other Challenge) bool {
+	if p.Year != other.Year {
+		return p.Year < other.Year
+	}
+	return p.Day < other.Day
+}
+
 func problemDir(year, day int) (string, error) {
-	wd, err := os.Getwd()
+	root, err := Root()
 	if err != nil {
 		return "", err
 	}
 
-	p := filepath.Join(wd, "events", fmt.Sprintf("%04d/%02d", year, day))
+	p := filepath.Join(root, "events", fmt.Sprintf("%04d", year), fmt.Sprintf("%02d", day))
 
 	return p, nil
 }
@@ -81,109 +95,68 @@ func problemInput(year, day int) (string, error) {
 	return filepath.Join(dir, "input.txt"), nil
 }
 
-func getReader(path string) io.Reader {
-	reader, writer := io.Pipe()
-	go func() {
-		file, openErr := os.Open(path)
-		if openErr != nil {
-			_ = writer.CloseWithError(openErr)
-			return
-		}
-		defer file.Close()
-
-		_, cErr := io.Copy(writer, file)
-		_ = writer.CloseWithError(cErr)
-	}()
-
-	return reader
-}
-
 func existsFile(path string) bool {
 	_, err := os.Stat(path)
 	return err == nil
 }
 
-var ErrCookie = errors.New("missing cookie.txt in root directory")
-
-func getCookie() (_ string, err error) {
-	wd, err := os.Getwd()
+func download(year, day int) error {
+	c, err := NewDefaultClient()
 	if err != nil {
-		return "", err
+		return err
 	}
 
-	fp := filepath.Join(wd, "cookie.txt")
-
-	if !existsFile(fp) {
-		return "", ErrCookie
-	}
+	// leave room for the throttle and retries of the transport
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
 
-	f, err := os.Open(fp)
+	reader, err := c.DownloadInput(ctx, year, day)
 	if err != nil {
-		return "", err
+		return fmt.Errorf("download: %w", err)
 	}
+	defer reader.Close()
 
-	defer func() {
-		cerr := f.Close()
-		if err == nil {
-			err = cerr
-		}
-	}()
+	return storeInput(year, day, reader)
+}
 
-	b, err := io.ReadAll(f)
-	if err != nil {
-		return "", err
-	}
+var ErrInvalidInput = errors.New("downloaded input is not a puzzle input")
 
-	return string(b), nil
-}
+// validateInput rejects login pages and error messages that the site may
+// serve instead of the input.
+func validateInput(b []byte) error {
+	trimmed := bytes.TrimSpace(b)
 
-func download(year, day int) error {
-	cookie, err := getCookie()
-	if err != nil {
-		return err
+	switch {
+	case len(trimmed) == 0:
+		return fmt.Errorf("%w: empty", ErrInvalidInput)
+	case bytes.HasPrefix(trimmed, []byte("<")), bytes.Contains(bytes.ToLower(trimmed), []byte("<html")):
+		return fmt.Errorf("%w: looks like HTML", ErrInvalidInput)
+	case bytes.HasPrefix(trimmed, []byte("Please")), bytes.HasPrefix(trimmed, []byte("Puzzle inputs differ by user")):
+		line, _, _ := bytes.Cut(trimmed, []byte("\n"))
+		return fmt.Errorf("%w: %s", ErrInvalidInput, line)
 	}
 
-	base, err := url.Parse(baseURL)
-	if err != nil {
-		return err
-	}
+	return nil
+}
 
-	c, err := NewClient(http.DefaultClient, base, cookie)
+func storeInput(year, day int, reader io.Reader) error {
+	input, err := problemInput(year, day)
 	if err != nil {
 		return err
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
-	defer cancel()
-
-	reader, err := c.DownloadInput(ctx, year, day)
+	b, err := io.ReadAll(reader)
 	if err != nil {
-		return fmt.Errorf("download: %w", err)
+		return fmt.Errorf("read: %w", err)
 	}
 
-	return storeInput(year, day, reader)
-}
-
-func storeInput(year, day int, reader io.Reader) (err error) {
-	input, err := problemInput(year, day)
-	if err != nil {
+	if err = validateInput(b); err != nil {
 		return err
 	}
-	f, err := os.Create(input)
-	if err != nil {
-		return fmt.Errorf("create: %w", err)
-	}
-	defer func() {
-		cerr := f.Close()
-		if err == nil {
-			err = cerr
-		}
-	}()
 
-	_, err = io.Copy(f, reader)
-	if err != nil {
-		return fmt.Errorf("copy: %w", err)
+	if err = os.WriteFile(input, b, 0644); err != nil {
+		return err
 	}
 
-	return nil
+	return recordChecksum(year, day, NormalizeInput(b))
 }
@@ -10,18 +10,28 @@ import (
 	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
+	"sync"
 )
 
 type Client struct {
 	client *http.Client
 	cookie string
 	base   *url.URL
+
+	mu   sync.Mutex
+	user *User // set once the session is validated
 }
 
 func NewDefaultClient() (*Client, error) {
-	cookie, err := getCookie()
+	return NewAccountClient("")
+}
+
+// NewAccountClient returns a client for the named account, see Session.
+func NewAccountClient(account string) (*Client, error) {
+	cookie, err := Session(account)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get cookie: %w", err)
+		return nil, fmt.Errorf("failed to get session: %w", err)
 	}
 
 	base, err := url.Parse(baseURL)
@@ -29,7 +39,7 @@ func NewDefaultClient() (*Client, error) {
 		return nil, fmt.Errorf("failed to parse base url: %w", err)
 	}
 
-	return NewClient(http.DefaultClient, base, cookie)
+	return NewClient(&http.Client{Transport: NewTransport()}, base, cookie)
 }
 
 func NewClient(client *http.Client, base *url.URL, cookie string) (*Client, error) {
@@ -70,6 +80,9 @@ func (c *Client) doRequest(req *http.Request) (*http.Response, error) {
 }
 
 func (c *Client) DownloadInput(ctx context.Context, year, day int) (io.ReadCloser, error) {
+	if err := c.validate(ctx); err != nil {
+		return nil, err
+	}
 
 	u := c.base.JoinPath(strconv.Itoa(year), "day", strconv.Itoa(day), "input")
 
@@ -91,30 +104,65 @@ func (c *Client) DownloadInput(ctx context.Context, year, day int) (io.ReadClose
 	return resp.Body, nil
 }
 
-func (c *Client) SubmitAnswer(ctx context.Context, year, day, part int, answer string) error {
-	u := c.base.JoinPath(strconv.Itoa(year), "day", strconv.Itoa(day), "answer")
+func (c *Client) DownloadPuzzle(ctx context.Context, year, day int) (*Puzzle, error) {
+	if err := c.validate(ctx); err != nil {
+		return nil, err
+	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u.String(), nil)
+	u := c.base.JoinPath(strconv.Itoa(year), "day", strconv.Itoa(day))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	q := req.URL.Query()
-	q.Add("level", strconv.Itoa(part))
-	q.Add("answer", answer)
-	req.URL.RawQuery = q.Encode()
+	resp, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("status code %d", resp.StatusCode)
+	}
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return parsePuzzle(string(b), u)
+}
+
+func (c *Client) SubmitAnswer(ctx context.Context, year, day, part int, answer string) (SubmitResult, error) {
+	u := c.base.JoinPath(strconv.Itoa(year), "day", strconv.Itoa(day), "answer")
+
+	form := url.Values{}
+	form.Set("level", strconv.Itoa(part))
+	form.Set("answer", answer)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u.String(), strings.NewReader(form.Encode()))
+	if err != nil {
+		return SubmitResult{}, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 
 	resp, err := c.doRequest(req)
 	if err != nil {
-		return err
+		return SubmitResult{}, err
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("status code %d", resp.StatusCode)
+		return SubmitResult{}, fmt.Errorf("status code %d", resp.StatusCode)
 	}
 
-	return nil
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return SubmitResult{}, err
+	}
+
+	return parseSubmitResponse(string(b))
 }
 
 func (c *Client) GetLeaderboard(ctx context.Context, year, group int) (*Leaderboard, error) {
@@ -152,16 +200,18 @@ type Leaderboard struct {
 }
 
 type Member struct {
-	Name               string `json:"name"`
-	LocalScore         int    `json:"local_score"`
-	LastStarTs         int    `json:"last_star_ts"`
-	Stars              int    `json:"stars"`
-	GlobalScore        int    `json:"global_score"`
-	Id                 int    `json:"id"`
-	CompletionDayLevel map[int]struct {
-		Part1 *Part `json:"1"`
-		Part2 *Part `json:"2"`
-	} `json:"completion_day_level"`
+	Name               string           `json:"name"`
+	LocalScore         int              `json:"local_score"`
+	LastStarTs         int              `json:"last_star_ts"`
+	Stars              int              `json:"stars"`
+	GlobalScore        int              `json:"global_score"`
+	Id                 int              `json:"id"`
+	CompletionDayLevel map[int]DayLevel `json:"completion_day_level"`
+}
+
+type DayLevel struct {
+	Part1 *Part `json:"1"`
+	Part2 *Part `json:"2"`
 }
 
 type Part struct {
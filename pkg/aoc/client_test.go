@@ -0,0 +1,230 @@
+package aoc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	base, err := url.Parse(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := NewClient(srv.Client(), base, "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return c
+}
+
+func article(msg string) string {
+	return `<html><body><main><article><p>` + msg + `</p></article></main></body></html>`
+}
+
+func TestClient_SubmitAnswer(t *testing.T) {
+	tests := []struct {
+		name string
+		page string
+		want SubmitResult
+	}{
+		{
+			name: "correct",
+			page: article(`That's the right answer!  You are <span class="day-success">one gold star</span> closer to saving Christmas.`),
+			want: SubmitResult{Status: Correct},
+		},
+		{
+			name: "incorrect",
+			page: article(`That's not the right answer.  If you're stuck, make sure you're using the full input data; please wait one minute before trying again. <a href="/2016/day/11">[Return to Day 11]</a>`),
+			want: SubmitResult{Status: Incorrect, Wait: time.Minute},
+		},
+		{
+			name: "too high",
+			page: article(`That's not the right answer; your answer is too high.  Please wait 5 minutes before trying again.`),
+			want: SubmitResult{Status: TooHigh, Wait: 5 * time.Minute},
+		},
+		{
+			name: "too low",
+			page: article(`That's not the right answer; your answer is too low.`),
+			want: SubmitResult{Status: TooLow},
+		},
+		{
+			name: "rate limited",
+			page: article(`You gave an answer too recently; you have to wait after submitting an answer before trying again.  You have 4m 12s left to wait.`),
+			want: SubmitResult{Status: RateLimited, Wait: 4*time.Minute + 12*time.Second},
+		},
+		{
+			name: "already solved",
+			page: article(`You don't seem to be solving the right level.  Did you already complete it?`),
+			want: SubmitResult{Status: AlreadySolved},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost || r.URL.Path != "/2016/day/11/answer" {
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+				}
+				if cookie, err := r.Cookie("session"); err != nil || cookie.Value != "secret" {
+					t.Errorf("missing session cookie")
+				}
+				if level, answer := r.PostFormValue("level"), r.PostFormValue("answer"); level != "2" || answer != "42" {
+					t.Errorf("form = level %q answer %q, want level 2 answer 42", level, answer)
+				}
+				_, _ = w.Write([]byte(tt.page))
+			})
+
+			got, err := c.SubmitAnswer(context.Background(), 2016, 11, 2, "42")
+			if err != nil {
+				t.Fatalf("SubmitAnswer() error = %v", err)
+			}
+			if got.Status != tt.want.Status || got.Wait != tt.want.Wait {
+				t.Errorf("SubmitAnswer() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+const puzzlePage = `<!DOCTYPE html>
+<html lang="en-us">
+<body>
+<main>
+<article class="day-desc"><h2>--- Day 9: Explosives in Cyberspace ---</h2><p>Wandering around a secure area, you come across a datalink port to a new part of the network.</p>
+<p>The format <em>compresses</em> a sequence of characters. For example:</p>
+<ul>
+<li><code>ADVENT</code> contains no markers and decompresses to itself with no changes, resulting in a decompressed length of <code><em>6</em></code>.</li>
+</ul>
+<pre><code>A(1x5)BC
+X(8x2)(3x3)ABCY
+</code></pre>
+<p>See the <a href="/2016/about">about page</a>.</p>
+</article>
+<p>Your puzzle answer was <code>123</code>.</p>
+</main>
+</body>
+</html>
+`
+
+const puzzleMarkdown = "## --- Day 9: Explosives in Cyberspace ---\n\n" +
+	"Wandering around a secure area, you come across a datalink port to a new part of the network.\n\n" +
+	"The format **compresses** a sequence of characters. For example:\n\n" +
+	"- `ADVENT` contains no markers and decompresses to itself with no changes, resulting in a decompressed length of `6`.\n\n" +
+	"```\nA(1x5)BC\nX(8x2)(3x3)ABCY\n```\n\n" +
+	"See the [about page](%s/2016/about).\n"
+
+func TestClient_DownloadPuzzle(t *testing.T) {
+	var host string
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		host = "http://" + r.Host
+		switch r.URL.Path {
+		case "/":
+			_, _ = w.Write([]byte(homePage))
+		case "/2016/day/9":
+			_, _ = w.Write([]byte(puzzlePage))
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	})
+
+	got, err := c.DownloadPuzzle(context.Background(), 2016, 9)
+	if err != nil {
+		t.Fatalf("DownloadPuzzle() error = %v", err)
+	}
+
+	if got.Title != "Explosives in Cyberspace" {
+		t.Errorf("Title = %q", got.Title)
+	}
+
+	if want := fmt.Sprintf(puzzleMarkdown, host); got.Markdown != want {
+		t.Errorf("Markdown = \n%s\nwant\n%s", got.Markdown, want)
+	}
+
+	if len(got.Examples) != 1 || got.Examples[0] != "A(1x5)BC\nX(8x2)(3x3)ABCY\n" {
+		t.Errorf("Examples = %q", got.Examples)
+	}
+}
+
+const homePage = `<header><div><h1 class="title-global"><a href="/">Advent of Code</a></h1><nav><ul><li><a href="/2023/about">[About]</a></li></ul></nav>` +
+	`<div class="user">Pim van Hespen <a href="/2023/support" class="supporter-badge" title="Advent of Code Supporter">(AoC++)</a> <span class="star-count">42*</span></div></div></header>`
+
+const loginPage = `<header><div><h1 class="title-global"><a href="/">Advent of Code</a></h1>` +
+	`<nav><ul><li><a href="/2023/auth/login">[Log In]</a></li></ul></nav></div></header>`
+
+func TestClient_Whoami(t *testing.T) {
+	tests := []struct {
+		name    string
+		page    string
+		want    User
+		wantErr error
+	}{
+		{"supporter", homePage, User{Name: "Pim van Hespen", Tier: "AoC++", Stars: 42}, nil},
+		{"anonymous", strings.Replace(homePage, "Pim van Hespen", "(anonymous user #123)", 1), User{Name: "(anonymous user #123)", Tier: "AoC++", Stars: 42}, nil},
+		{"logged out", loginPage, User{}, ErrLoggedOut},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(tt.page))
+			})
+
+			got, err := c.Whoami(context.Background())
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Whoami() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && *got != tt.want {
+				t.Errorf("Whoami() = %+v, want %+v", *got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_DownloadInput_LoggedOut(t *testing.T) {
+	var downloaded bool
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/" {
+			downloaded = true
+		}
+		_, _ = w.Write([]byte(loginPage))
+	})
+
+	if _, err := c.DownloadInput(context.Background(), 2016, 9); !errors.Is(err, ErrLoggedOut) {
+		t.Errorf("DownloadInput() error = %v, want %v", err, ErrLoggedOut)
+	}
+	if downloaded {
+		t.Errorf("DownloadInput() requested the input with an invalid session")
+	}
+}
+
+func Test_validateInput(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr bool
+	}{
+		{"input", "ADVENT\n", false},
+		{"empty", "\n", true},
+		{"html", "<!DOCTYPE html>\n<html lang=\"en-us\">", true},
+		{"login", "Puzzle inputs differ by user.  Please log in to get your puzzle input.\n", true},
+		{"too early", "Please don't repeatedly request this endpoint before it unlocks!", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateInput([]byte(tt.input)); (err != nil) != tt.wantErr {
+				t.Errorf("validateInput() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
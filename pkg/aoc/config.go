@@ -0,0 +1,233 @@
+package aoc
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+var ErrNoRoot = errors.New("no go.mod found in working directory or its parents")
+
+var ErrNoSession = errors.New("no session: set AOC_SESSION, configure an account in config.toml or add cookie.txt to the repository root")
+
+// Root returns the root of the repository, which is the closest directory
+// containing go.mod, starting at the working directory.
+func Root() (string, error) {
+	dir, err := os.Getwd()
+	if err != nil {
+		return "", err
+	}
+
+	for {
+		if existsFile(filepath.Join(dir, "go.mod")) {
+			return dir, nil
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", ErrNoRoot
+		}
+		dir = parent
+	}
+}
+
+// Account is a login on the site, identified by its session cookie.
+type Account struct {
+	Name    string
+	Session string
+}
+
+// Config is the user configuration, read from config.toml:
+//
+//	account = "personal" # the account used when none is selected
+//
+//	[accounts.personal]
+//	session = "53616c7465645f5f..."
+//
+//	[accounts.work]
+//	session = "53616c7465645f5f..."
+type Config struct {
+	Account  string
+	Accounts map[string]Account
+}
+
+// ConfigPath returns the location of config.toml, $XDG_CONFIG_HOME/aoc/config.toml
+// or ~/.config/aoc/config.toml when XDG_CONFIG_HOME is not set.
+func ConfigPath() (string, error) {
+	dir := os.Getenv("XDG_CONFIG_HOME")
+	if dir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", err
+		}
+		dir = filepath.Join(home, ".config")
+	}
+
+	return filepath.Join(dir, "aoc", "config.toml"), nil
+}
+
+// LoadConfig reads config.toml. A missing file is an empty configuration.
+func LoadConfig() (*Config, error) {
+	fp, err := ConfigPath()
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(fp)
+	if errors.Is(err, fs.ErrNotExist) {
+		return &Config{Accounts: make(map[string]Account)}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	c, err := ParseConfig(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", fp, err)
+	}
+
+	return c, nil
+}
+
+// ParseConfig parses the configuration from the subset of TOML that config.toml uses.
+func ParseConfig(r io.Reader) (*Config, error) {
+	tables, err := parseTOML(r)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &Config{
+		Account:  tables[""]["account"],
+		Accounts: make(map[string]Account),
+	}
+
+	for table, values := range tables {
+		name, ok := strings.CutPrefix(table, "accounts.")
+		if !ok {
+			continue
+		}
+
+		c.Accounts[name] = Account{
+			Name:    name,
+			Session: values["session"],
+		}
+	}
+
+	return c, nil
+}
+
+// Session returns the session of the named account. An empty name selects
+// the account in AOC_ACCOUNT. The sources are tried in order:
+//
+//  1. AOC_SESSION, unless an account is selected
+//  2. the selected account in config.toml, or its default account
+//  3. cookie.txt in the repository root, unless an account is selected
+func Session(account string) (string, error) {
+	if account == "" {
+		account = os.Getenv("AOC_ACCOUNT")
+	}
+
+	if s := os.Getenv("AOC_SESSION"); s != "" && account == "" {
+		return s, nil
+	}
+
+	c, err := LoadConfig()
+	if err != nil {
+		return "", err
+	}
+
+	name := account
+	if name == "" {
+		name = c.Account
+	}
+
+	if a, ok := c.Accounts[name]; ok && a.Session != "" {
+		return a.Session, nil
+	}
+
+	if account != "" {
+		return "", fmt.Errorf("account %q: %w", account, ErrNoSession)
+	}
+
+	return getCookie()
+}
+
+func getCookie() (_ string, err error) {
+	root, err := Root()
+	if err != nil {
+		return "", err
+	}
+
+	b, err := os.ReadFile(filepath.Join(root, "cookie.txt"))
+	if errors.Is(err, fs.ErrNotExist) {
+		return "", ErrNoSession
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(string(b)), nil
+}
+
+// parseTOML parses tables of string, integer and boolean keys. All values
+// are returned as strings; keys before the first table are in table "".
+func parseTOML(r io.Reader) (map[string]map[string]string, error) {
+	tables := map[string]map[string]string{"": {}}
+	table := ""
+
+	scanner := bufio.NewScanner(r)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(stripComment(scanner.Text()))
+
+		switch {
+		case line == "":
+			continue
+		case strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]"):
+			table = strings.TrimSpace(line[1 : len(line)-1])
+			if _, ok := tables[table]; !ok {
+				tables[table] = make(map[string]string)
+			}
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, fmt.Errorf("line %d: expected key = value", n)
+		}
+
+		key, value = strings.TrimSpace(key), strings.TrimSpace(value)
+
+		if strings.HasPrefix(value, `"`) {
+			s, err := strconv.Unquote(value)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: invalid string %s", n, value)
+			}
+			value = s
+		}
+
+		tables[table][key] = value
+	}
+
+	return tables, scanner.Err()
+}
+
+// stripComment removes a trailing comment that is not inside a string.
+func stripComment(line string) string {
+	quoted := false
+	for i, c := range line {
+		switch {
+		case c == '"' && (i == 0 || line[i-1] != '\\'):
+			quoted = !quoted
+		case c == '#' && !quoted:
+			return line[:i]
+		}
+	}
+	return line
+}
@@ -0,0 +1,95 @@
+package aoc
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const exampleConfig = `
+# accounts used to download inputs and submit answers
+account = "personal"
+
+[accounts.personal]
+session = "abc" # the cookie named session
+
+[accounts.work]
+session = "d#f"
+`
+
+func TestParseConfig(t *testing.T) {
+	got, err := ParseConfig(strings.NewReader(exampleConfig))
+	if err != nil {
+		t.Fatalf("ParseConfig() error = %v", err)
+	}
+
+	want := &Config{
+		Account: "personal",
+		Accounts: map[string]Account{
+			"personal": {Name: "personal", Session: "abc"},
+			"work":     {Name: "work", Session: "d#f"},
+		},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseConfig() = %+v, want %+v", got, want)
+	}
+
+	if _, err = ParseConfig(strings.NewReader("session")); err == nil {
+		t.Errorf("ParseConfig() expected error for missing value")
+	}
+}
+
+func TestSession(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "aoc"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "aoc", "config.toml"), []byte(exampleConfig), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Setenv("XDG_CONFIG_HOME", dir)
+	t.Setenv("AOC_ACCOUNT", "")
+	t.Setenv("AOC_SESSION", "env")
+
+	tests := []struct {
+		name    string
+		account string
+		want    string
+		wantErr bool
+	}{
+		{"environment first", "", "env", false},
+		{"named account", "work", "d#f", false},
+		{"unknown account", "other", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Session(tt.account)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Session() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Session() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	t.Setenv("AOC_SESSION", "")
+	if got, _ := Session(""); got != "abc" {
+		t.Errorf("Session() = %q, want default account %q", got, "abc")
+	}
+}
+
+func TestRoot(t *testing.T) {
+	root, err := Root()
+	if err != nil {
+		t.Fatalf("Root() error = %v", err)
+	}
+
+	if !existsFile(filepath.Join(root, "go.mod")) || !existsFile(filepath.Join(root, "events")) {
+		t.Errorf("Root() = %s, not the repository root", root)
+	}
+}
@@ -0,0 +1,204 @@
+package aoc
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+)
+
+var ErrOffline = errors.New("input.txt is missing and offline mode is enabled")
+
+var ErrInputModified = errors.New("input does not match its checksum in inputs.sum, restore it or remove its line from inputs.sum")
+
+var ErrNoInput = errors.New("no such input")
+
+// DefaultInput is the name of the personal puzzle input of a challenge.
+const DefaultInput = "input.txt"
+
+// inputFile returns the file name of a named input: example1 is stored as example1.txt.
+func inputFile(name string) string {
+	if filepath.Ext(name) == "" {
+		name += ".txt"
+	}
+	return name
+}
+
+// Inputs returns the names of the inputs stored with the challenge, such as
+// input.txt, example1.txt or input-alice.txt.
+func (p Challenge) Inputs() ([]string, error) {
+	dir, err := problemDir(p.Year, p.Day)
+	if err != nil {
+		return nil, err
+	}
+
+	matches, err := filepath.Glob(filepath.Join(dir, "*.txt"))
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, len(matches))
+	for i, m := range matches {
+		names[i] = filepath.Base(m)
+	}
+
+	return names, nil
+}
+
+// NamedInput returns the normalized input stored under name with the challenge.
+// Unlike Input it is never downloaded nor checked against inputs.sum.
+func (p Challenge) NamedInput(name string) (io.Reader, error) {
+	dir, err := problemDir(p.Year, p.Day)
+	if err != nil {
+		return nil, err
+	}
+
+	name = inputFile(name)
+
+	b, err := os.ReadFile(filepath.Join(dir, name))
+	if errors.Is(err, fs.ErrNotExist) {
+		names, _ := p.Inputs()
+		return nil, fmt.Errorf("%s: %s: %w, have %s", p, name, ErrNoInput, strings.Join(names, ", "))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return bytes.NewReader(NormalizeInput(b)), nil
+}
+
+// Offline reports whether offline mode is enabled with AOC_OFFLINE.
+// In offline mode missing inputs are never downloaded.
+func Offline() bool {
+	switch strings.ToLower(os.Getenv("AOC_OFFLINE")) {
+	case "", "0", "false", "no":
+		return false
+	default:
+		return true
+	}
+}
+
+// NormalizeInput converts line endings to LF and ends the input with a single newline.
+func NormalizeInput(b []byte) []byte {
+	b = bytes.ReplaceAll(b, []byte("\r\n"), []byte("\n"))
+	b = bytes.TrimRight(b, "\n")
+	return append(b, '\n')
+}
+
+func checksum(b []byte) string {
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
+
+// manifestMu guards inputs.sum against concurrent updates within the process.
+var manifestMu sync.Mutex
+
+func manifestPath() (string, error) {
+	root, err := Root()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(root, "inputs.sum"), nil
+}
+
+// readManifest reads inputs.sum, which uses the format of sha256sum with
+// paths relative to the repository root.
+func readManifest() (map[string]string, error) {
+	fp, err := manifestPath()
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(fp)
+	if errors.Is(err, fs.ErrNotExist) {
+		return make(map[string]string), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	sums := make(map[string]string)
+
+	scanner := bufio.NewScanner(f)
+	for n := 1; scanner.Scan(); n++ {
+		sum, path, ok := strings.Cut(scanner.Text(), "  ")
+		if !ok {
+			return nil, fmt.Errorf("inputs.sum line %d: expected checksum and path", n)
+		}
+		sums[path] = sum
+	}
+
+	return sums, scanner.Err()
+}
+
+func writeManifest(sums map[string]string) error {
+	fp, err := manifestPath()
+	if err != nil {
+		return err
+	}
+
+	paths := make([]string, 0, len(sums))
+	for p := range sums {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+
+	var buf bytes.Buffer
+	for _, p := range paths {
+		_, _ = fmt.Fprintf(&buf, "%s  %s\n", sums[p], p)
+	}
+
+	return os.WriteFile(fp, buf.Bytes(), 0644)
+}
+
+func manifestKey(year, day int) string {
+	return fmt.Sprintf("events/%04d/%02d/input.txt", year, day)
+}
+
+// recordChecksum stores the checksum of a (normalized) input in inputs.sum.
+func recordChecksum(year, day int, b []byte) error {
+	manifestMu.Lock()
+	defer manifestMu.Unlock()
+
+	sums, err := readManifest()
+	if err != nil {
+		return err
+	}
+
+	sums[manifestKey(year, day)] = checksum(b)
+
+	return writeManifest(sums)
+}
+
+// verifyChecksum compares a (normalized) input to inputs.sum. Inputs without
+// a checksum are recorded, so inputs stored before the manifest existed are
+// trusted on first use.
+func verifyChecksum(year, day int, b []byte) error {
+	manifestMu.Lock()
+	sums, err := readManifest()
+	manifestMu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	want, ok := sums[manifestKey(year, day)]
+	if !ok {
+		return recordChecksum(year, day, b)
+	}
+
+	if checksum(b) != want {
+		return fmt.Errorf("%s: %w", manifestKey(year, day), ErrInputModified)
+	}
+
+	return nil
+}
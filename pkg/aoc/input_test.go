@@ -0,0 +1,121 @@
+package aoc
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestNormalizeInput(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{"unchanged", "1\n2\n", "1\n2\n"},
+		{"missing newline", "1\n2", "1\n2\n"},
+		{"trailing newlines", "1\n2\n\n\n", "1\n2\n"},
+		{"crlf", "1\r\n2\r\n", "1\n2\n"},
+		{"leading whitespace is kept", "  1\n", "  1\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := string(NormalizeInput([]byte(tt.input))); got != tt.want {
+				t.Errorf("NormalizeInput() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// tempRoot makes a temporary directory with a go.mod the working directory,
+// so Root resolves to it.
+func tempRoot(t *testing.T) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "go.mod"), []byte("module example\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if err := os.Chdir(wd); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	return dir
+}
+
+func writeInput(t *testing.T, root, content string) {
+	t.Helper()
+
+	dir := filepath.Join(root, "events", "2015", "01")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "input.txt"), []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestChallenge_input_offline(t *testing.T) {
+	root := tempRoot(t)
+
+	_, err := NewChallenge(2015, 1).input(true)
+	if !errors.Is(err, ErrOffline) {
+		t.Fatalf("input() error = %v, want %v", err, ErrOffline)
+	}
+
+	if existsFile(filepath.Join(root, "events", "2015", "01", "input.txt")) {
+		t.Error("input() downloaded the input in offline mode")
+	}
+}
+
+func TestChallenge_input_checksum(t *testing.T) {
+	root := tempRoot(t)
+	writeInput(t, root, "(()\r\n\r\n")
+
+	r, err := NewChallenge(2015, 1).input(true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b, _ := io.ReadAll(r); string(b) != "(()\n" {
+		t.Errorf("input() = %q, want the normalized input", b)
+	}
+
+	// the first use records the checksum of the normalized input
+	b, err := os.ReadFile(filepath.Join(root, "inputs.sum"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := checksum([]byte("(()\n")) + "  events/2015/01/input.txt\n"; string(b) != want {
+		t.Errorf("inputs.sum = %q, want %q", b, want)
+	}
+
+	// line endings are not a modification
+	writeInput(t, root, "(()\n")
+	if _, err = NewChallenge(2015, 1).input(true); err != nil {
+		t.Errorf("input() of the same input error = %v", err)
+	}
+
+	writeInput(t, root, "())\n")
+	if _, err = NewChallenge(2015, 1).input(true); !errors.Is(err, ErrInputModified) {
+		t.Errorf("input() of a modified input error = %v, want %v", err, ErrInputModified)
+	}
+
+	if err = verifyChecksum(2015, 2, []byte("x\n")); err != nil {
+		t.Fatal(err)
+	}
+	if err = verifyChecksum(2015, 2, []byte("y\n")); !errors.Is(err, ErrInputModified) {
+		t.Errorf("verifyChecksum() error = %v, want %v", err, ErrInputModified)
+	}
+}
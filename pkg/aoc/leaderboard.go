@@ -0,0 +1,223 @@
+package aoc
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// Scoring determines how members of a leaderboard are ranked.
+type Scoring string
+
+const (
+	// LocalScore ranks by the score the site computes for private leaderboards.
+	LocalScore Scoring = "local"
+	// StarsOnly ranks by the number of stars, earliest last star first.
+	StarsOnly Scoring = "stars"
+	// MedianSolve ranks by the median time between unlock and star.
+	MedianSolve Scoring = "median"
+)
+
+func ParseScoring(s string) (Scoring, error) {
+	switch sc := Scoring(s); sc {
+	case LocalScore, StarsOnly, MedianSolve:
+		return sc, nil
+	default:
+		return "", fmt.Errorf("unknown scoring %q", s)
+	}
+}
+
+// Standing is the position of a member on a leaderboard.
+type Standing struct {
+	Rank   int
+	Member Member
+}
+
+// Year returns the year of the event.
+func (l *Leaderboard) Year() (int, error) {
+	return strconv.Atoi(l.Event)
+}
+
+// Standings ranks the members using the given scoring. Members with an equal
+// score share a rank.
+func (l *Leaderboard) Standings(scoring Scoring) []Standing {
+	year, _ := l.Year()
+
+	members := make([]Member, 0, len(l.Members))
+	for _, m := range l.Members {
+		members = append(members, m)
+	}
+
+	// key returns values that sort ascending, ties are broken by the next value
+	key := func(m Member) []int64 {
+		switch scoring {
+		case StarsOnly:
+			return []int64{-int64(m.Stars), int64(m.LastStarTs)}
+		case MedianSolve:
+			median, ok := m.MedianSolveTime(year)
+			if !ok {
+				return []int64{1, 0}
+			}
+			return []int64{0, int64(median)}
+		default:
+			return []int64{-int64(m.LocalScore)}
+		}
+	}
+
+	less := func(a, b []int64) int {
+		for i := range a {
+			switch {
+			case a[i] < b[i]:
+				return -1
+			case a[i] > b[i]:
+				return 1
+			}
+		}
+		return 0
+	}
+
+	sort.Slice(members, func(i, j int) bool {
+		if c := less(key(members[i]), key(members[j])); c != 0 {
+			return c < 0
+		}
+		return members[i].Id < members[j].Id
+	})
+
+	standings := make([]Standing, len(members))
+	for i, m := range members {
+		rank := i + 1
+		if i > 0 && less(key(members[i-1]), key(m)) == 0 {
+			rank = standings[i-1].Rank
+		}
+		standings[i] = Standing{Rank: rank, Member: m}
+	}
+
+	return standings
+}
+
+// DisplayName returns the name of the member as the site shows it.
+func (m Member) DisplayName() string {
+	if m.Name == "" {
+		return fmt.Sprintf("(anonymous user #%d)", m.Id)
+	}
+	return m.Name
+}
+
+// StarsOn returns the number of stars the member earned on the given day.
+func (m Member) StarsOn(day int) int {
+	level, ok := m.CompletionDayLevel[day]
+	if !ok {
+		return 0
+	}
+
+	var stars int
+	if level.Part1 != nil {
+		stars++
+	}
+	if level.Part2 != nil {
+		stars++
+	}
+	return stars
+}
+
+// SolveTimes returns the time between unlock and star for all stars of the member.
+func (m Member) SolveTimes(year int) []time.Duration {
+	var times []time.Duration
+	for day, level := range m.CompletionDayLevel {
+		for _, p := range []*Part{level.Part1, level.Part2} {
+			if p != nil {
+				times = append(times, p.Time().Sub(Unlock(year, day)))
+			}
+		}
+	}
+	return times
+}
+
+// MedianSolveTime returns the median time between unlock and star.
+func (m Member) MedianSolveTime(year int) (time.Duration, bool) {
+	times := m.SolveTimes(year)
+	if len(times) == 0 {
+		return 0, false
+	}
+
+	sort.Slice(times, func(i, j int) bool { return times[i] < times[j] })
+
+	mid := len(times) / 2
+	if len(times)%2 == 0 {
+		return (times[mid-1] + times[mid]) / 2, true
+	}
+	return times[mid], true
+}
+
+// Delta returns the time between the first and the second star of the day.
+func (d DayLevel) Delta() (time.Duration, bool) {
+	if d.Part1 == nil || d.Part2 == nil {
+		return 0, false
+	}
+	return d.Part2.Time().Sub(d.Part1.Time()), true
+}
+
+// Time returns the moment the star was earned.
+func (p *Part) Time() time.Time {
+	return time.Unix(int64(p.GetStarTs), 0)
+}
+
+// Unlock returns the moment the puzzle of the given day unlocks, midnight EST.
+func Unlock(year, day int) time.Time {
+	return time.Date(year, time.December, day, 5, 0, 0, 0, time.UTC)
+}
+
+// StarEvent is a star earned by a member.
+type StarEvent struct {
+	Member string    `json:"member"`
+	ID     int       `json:"id"`
+	Day    int       `json:"day"`
+	Part   int       `json:"part"`
+	Time   time.Time `json:"time"`
+}
+
+func (e StarEvent) String() string {
+	return fmt.Sprintf("%s got star %d on day %d", e.Member, e.Part, e.Day)
+}
+
+// NewStars returns the stars on l that were not on the previous leaderboard,
+// ordered by the moment they were earned.
+func (l *Leaderboard) NewStars(previous *Leaderboard) []StarEvent {
+	var events []StarEvent
+
+	for id, m := range l.Members {
+		old := previous.Members[id]
+
+		for day, level := range m.CompletionDayLevel {
+			before := old.CompletionDayLevel[day]
+
+			// pairs of the star now and the star before
+			stars := [][2]*Part{{level.Part1, before.Part1}, {level.Part2, before.Part2}}
+
+			for i, star := range stars {
+				p, had := star[0], star[1] != nil
+				if p == nil || had {
+					continue
+				}
+
+				events = append(events, StarEvent{
+					Member: m.DisplayName(),
+					ID:     m.Id,
+					Day:    day,
+					Part:   i + 1,
+					Time:   p.Time(),
+				})
+			}
+		}
+	}
+
+	sort.Slice(events, func(i, j int) bool {
+		if !events[i].Time.Equal(events[j].Time) {
+			return events[i].Time.Before(events[j].Time)
+		}
+		return events[i].ID < events[j].ID
+	})
+
+	return events
+}
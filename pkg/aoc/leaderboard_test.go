@@ -0,0 +1,98 @@
+package aoc
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+// unlock of 2023 day 1 is 1701406800
+const leaderboardJSON = `{
+	"owner_id": 1,
+	"event": "2023",
+	"members": {
+		"1": {"id": 1, "name": "alice", "local_score": 10, "stars": 3, "last_star_ts": 1701493500,
+			"completion_day_level": {
+				"1": {"1": {"get_star_ts": 1701406860, "star_index": 1}, "2": {"get_star_ts": 1701407100, "star_index": 2}},
+				"2": {"1": {"get_star_ts": 1701493500, "star_index": 5}}
+			}},
+		"2": {"id": 2, "name": null, "local_score": 12, "stars": 3, "last_star_ts": 1701493400,
+			"completion_day_level": {
+				"1": {"1": {"get_star_ts": 1701410400, "star_index": 3}, "2": {"get_star_ts": 1701414000, "star_index": 4}},
+				"2": {"1": {"get_star_ts": 1701493400, "star_index": 6}}
+			}},
+		"3": {"id": 3, "name": "carol", "local_score": 0, "stars": 0, "last_star_ts": 0, "completion_day_level": {}}
+	}
+}`
+
+func TestLeaderboard_Standings(t *testing.T) {
+	var lb Leaderboard
+	if err := json.Unmarshal([]byte(leaderboardJSON), &lb); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		scoring Scoring
+		want    []string
+	}{
+		{LocalScore, []string{"(anonymous user #2)", "alice", "carol"}},
+		{StarsOnly, []string{"(anonymous user #2)", "alice", "carol"}},
+		{MedianSolve, []string{"alice", "(anonymous user #2)", "carol"}},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.scoring), func(t *testing.T) {
+			standings := lb.Standings(tt.scoring)
+			if len(standings) != len(tt.want) {
+				t.Fatalf("Standings() = %d members, want %d", len(standings), len(tt.want))
+			}
+			for i, s := range standings {
+				if got := s.Member.DisplayName(); got != tt.want[i] || s.Rank != i+1 {
+					t.Errorf("Standings()[%d] = #%d %s, want #%d %s", i, s.Rank, got, i+1, tt.want[i])
+				}
+			}
+		})
+	}
+
+	alice := lb.Members["1"]
+	if median, _ := alice.MedianSolveTime(2023); median != 5*time.Minute {
+		t.Errorf("MedianSolveTime() = %s, want 5m", median)
+	}
+	if delta, _ := alice.CompletionDayLevel[1].Delta(); delta != 4*time.Minute {
+		t.Errorf("Delta() = %s, want 4m", delta)
+	}
+	if got := alice.StarsOn(2); got != 1 {
+		t.Errorf("StarsOn(2) = %d, want 1", got)
+	}
+}
+
+func TestLeaderboard_NewStars(t *testing.T) {
+	var previous, current Leaderboard
+	if err := json.Unmarshal([]byte(leaderboardJSON), &previous); err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal([]byte(leaderboardJSON), &current); err != nil {
+		t.Fatal(err)
+	}
+
+	alice := current.Members["1"]
+	alice.CompletionDayLevel[2] = DayLevel{
+		Part1: alice.CompletionDayLevel[2].Part1,
+		Part2: &Part{GetStarTs: 1701494000},
+	}
+	alice.CompletionDayLevel[3] = DayLevel{Part1: &Part{GetStarTs: 1701580000}}
+
+	if got := previous.NewStars(&previous); len(got) != 0 {
+		t.Errorf("NewStars() of itself = %v, want none", got)
+	}
+
+	got := current.NewStars(&previous)
+	want := []string{"alice got star 2 on day 2", "alice got star 1 on day 3"}
+	if len(got) != len(want) {
+		t.Fatalf("NewStars() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i].String() != want[i] {
+			t.Errorf("NewStars()[%d] = %s, want %s", i, got[i], want[i])
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package aoc
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	letterWidth  = 5 // including the blank column between letters
+	letterHeight = 6
+)
+
+// letters are the block letters of the displays in the puzzles, keyed by
+// their pixels row by row.
+var letters = map[string]rune{
+	".##..#..#.#..#.####.#..#.#..#.": 'A',
+	"###..#..#.###..#..#.#..#.###..": 'B',
+	".##..#..#.#....#....#..#..##..": 'C',
+	"####.#....###..#....#....####.": 'E',
+	"####.#....###..#....#....#....": 'F',
+	".##..#..#.#....#.##.#..#..###.": 'G',
+	"#..#.#..#.####.#..#.#..#.#..#.": 'H',
+	".###...#....#....#....#...###.": 'I',
+	"..##....#....#....#.#..#..##..": 'J',
+	"#..#.#.#..##...#.#..#.#..#..#.": 'K',
+	"#....#....#....#....#....####.": 'L',
+	".##..#..#.#..#.#..#.#..#..##..": 'O',
+	"###..#..#.#..#.###..#....#....": 'P',
+	"###..#..#.#..#.###..#.#..#..#.": 'R',
+	".###.#....#.....##.....#.###..": 'S',
+	"#..#.#..#.#..#.#..#.#..#..##..": 'U',
+	"#...##...#.#.#...#....#....#..": 'Y',
+	"####....#...#...#...#....####.": 'Z',
+}
+
+// ReadLetters reads the 6 pixel high block letters the puzzles draw on
+// screens, every letter in a column of 5 pixels. Blank columns are skipped.
+func ReadLetters(s Screen) (string, error) {
+	width, height := s.Size()
+	if height != letterHeight {
+		return "", fmt.Errorf("ocr: screen is %d pixels high, want %d", height, letterHeight)
+	}
+
+	var sb strings.Builder
+	for x0 := 0; x0 < width; x0 += letterWidth {
+		var key strings.Builder
+		var lit bool
+
+		for y := 0; y < height; y++ {
+			for x := x0; x < x0+letterWidth; x++ {
+				if x < width && s.Lit(x, y) {
+					key.WriteByte('#')
+					lit = true
+				} else {
+					key.WriteByte('.')
+				}
+			}
+		}
+
+		if !lit {
+			continue
+		}
+
+		r, ok := letters[key.String()]
+		if !ok {
+			return "", fmt.Errorf("ocr: unknown letter at x=%d in\n%s", x0, Draw(s))
+		}
+		sb.WriteRune(r)
+	}
+
+	return sb.String(), nil
+}
+
+// Draw renders a screen with # for lit and . for dark pixels.
+func Draw(s Screen) string {
+	width, height := s.Size()
+
+	var sb strings.Builder
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			if s.Lit(x, y) {
+				sb.WriteByte('#')
+			} else {
+				sb.WriteByte('.')
+			}
+		}
+		sb.WriteByte('\n')
+	}
+
+	return sb.String()
+}
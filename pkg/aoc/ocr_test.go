@@ -0,0 +1,83 @@
+package aoc
+
+import "testing"
+
+// pixels is a screen drawn with # and . for tests.
+type pixels []string
+
+func (p pixels) Size() (int, int) {
+	return len(p[0]), len(p)
+}
+
+func (p pixels) Lit(x, y int) bool {
+	return p[y][x] == '#'
+}
+
+func TestReadLetters(t *testing.T) {
+	tests := []struct {
+		name    string
+		screen  pixels
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "letters",
+			screen: pixels{
+				"#..#.####.#....#.....##..#...#",
+				"#..#.#....#....#....#..#.#...#",
+				"####.###..#....#....#..#..#.#.",
+				"#..#.#....#....#....#..#...#..",
+				"#..#.#....#....#....#..#...#..",
+				"#..#.####.####.####..##....#..",
+			},
+			want: "HELLOY",
+		},
+		{
+			name: "blank columns",
+			screen: pixels{
+				".....####.....",
+				"........#.....",
+				".......#......",
+				"......#.......",
+				".....#........",
+				".....####.....",
+			},
+			want: "Z",
+		},
+		{
+			name: "unknown letter",
+			screen: pixels{
+				"#####",
+				"#####",
+				"#####",
+				"#####",
+				"#####",
+				"#####",
+			},
+			wantErr: true,
+		},
+		{
+			name:    "height",
+			screen:  pixels{"#"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ReadLetters(tt.screen)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ReadLetters() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ReadLetters() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDraw(t *testing.T) {
+	want := "#.\n.#\n"
+	if got := Draw(pixels{"#.", ".#"}); got != want {
+		t.Errorf("Draw() = %q, want %q", got, want)
+	}
+}
@@ -12,16 +12,24 @@ import (
 
 var IgnoreLine = errors.New("ignore line")
 
+// ParseLines calls fn for every line of reader and collects the results.
+// Lines for which fn returns IgnoreLine are skipped. A ScanError of fn gets
+// the number of its line.
 func ParseLines[T any](reader io.Reader, fn func(string) (T, error)) ([]T, error) {
 	scanner := bufio.NewScanner(reader)
 	var result []T
+	var n int
 	for scanner.Scan() {
+		n++
 		line := scanner.Text()
 		value, err := fn(line)
 		if err != nil {
 			if errors.Is(err, IgnoreLine) {
 				continue
 			}
+			if se, ok := err.(*ScanError); ok && se.Line == 0 {
+				return nil, &ScanError{Line: n, Column: se.Column, Err: se.Err}
+			}
 			return nil, err
 		}
 		result = append(result, value)
@@ -73,6 +81,9 @@ func Ints(s string) ([]int, error) {
 	return nums, nil
 }
 
+// Grid is a grid of bytes.
+//
+// Deprecated: use grid.Grid, which supports any cell type.
 type Grid struct {
 	Width, Height int
 	Data          []byte
@@ -103,6 +114,9 @@ func (g Grid) String() string {
 	return buf.String()
 }
 
+// ParseGrid reads a Grid.
+//
+// Deprecated: use grid.Parse.
 func ParseGrid(reader io.Reader) (Grid, error) {
 	b, err := io.ReadAll(reader)
 	if err != nil {
@@ -0,0 +1,153 @@
+package aoc
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"runtime"
+	"runtime/pprof"
+	"runtime/trace"
+)
+
+// Profile is a kind of profile the runner can record while solving.
+type Profile string
+
+const (
+	CPUProfile Profile = "cpu"
+	// MemProfile is an allocation profile written before and after the solve
+	// phase, see WithProfile.
+	MemProfile   Profile = "mem"
+	TraceProfile Profile = "trace"
+)
+
+func problemProfiles(year, day int) (string, error) {
+	dir, err := problemDir(year, day)
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dir, "profiles"), nil
+}
+
+// profileFile returns the file a profile of a part is written to, such as
+// profiles/part1.cpu.pprof.
+func profileFile(dir, name string, kind Profile) string {
+	ext := ".pprof"
+	if kind == TraceProfile {
+		ext = ".out"
+	}
+
+	return filepath.Join(dir, fmt.Sprintf("%s.%s%s", name, kind, ext))
+}
+
+// profile records the given profiles of fn for the challenge.
+func profile(log *slog.Logger, c Challenge, name string, kinds []Profile, fn func() error) (err error) {
+	if len(kinds) == 0 {
+		return fn()
+	}
+
+	dir, err := problemProfiles(c.Year, c.Day)
+	if err != nil {
+		return err
+	}
+
+	if err = os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+
+	var files []*os.File
+	defer func() {
+		for _, f := range files {
+			if cerr := f.Close(); err == nil {
+				err = cerr
+			}
+			log.Info("Profiled", slog.String("File", f.Name()))
+		}
+	}()
+
+	create := func(path string) (*os.File, error) {
+		f, err := os.Create(path)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, f)
+		return f, nil
+	}
+
+	var mem, base *os.File
+	for _, kind := range kinds {
+		f, err := create(profileFile(dir, name, kind))
+		if err != nil {
+			return err
+		}
+
+		switch kind {
+		case CPUProfile:
+			if err = pprof.StartCPUProfile(f); err != nil {
+				return err
+			}
+			defer pprof.StopCPUProfile()
+		case TraceProfile:
+			if err = trace.Start(f); err != nil {
+				return err
+			}
+			defer trace.Stop()
+		case MemProfile:
+			mem = f
+			if base, err = create(profileFile(dir, name+".base", kind)); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("unknown profile %q", kind)
+		}
+	}
+
+	if base != nil {
+		if err = writeAllocs(base); err != nil {
+			return err
+		}
+	}
+
+	err = fn()
+
+	if mem != nil {
+		err = errors.Join(err, writeAllocs(mem))
+	}
+
+	return err
+}
+
+// writeAllocs writes the allocations of the process so far. The garbage
+// collection publishes the latest allocations.
+func writeAllocs(f *os.File) error {
+	runtime.GC()
+	return pprof.Lookup("allocs").WriteTo(f, 0)
+}
+
+// WithProfile records profiles of the solve phase of every part in the
+// profiles directory of the challenge. The allocations of a part are those
+// of part1.mem.pprof less those of part1.base.mem.pprof, which
+// go tool pprof -diff_base shows.
+func WithProfile(kinds ...Profile) Option {
+	return func(o *options) {
+		o.profiles = append(o.profiles, kinds...)
+	}
+}
+
+// WithProfilePart limits WithProfile to one part, zero profiles every part.
+// The other parts still run.
+func WithProfilePart(part int) Option {
+	return func(o *options) {
+		o.profilePart = part
+	}
+}
+
+// profilesOf returns the profiles to record of the part called name.
+func (d *Runner[Input]) profilesOf(name string) []Profile {
+	if d.profilePart != 0 && name != fmt.Sprintf("part%d", d.profilePart) {
+		return nil
+	}
+	return d.profiles
+}
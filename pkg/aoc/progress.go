@@ -0,0 +1,146 @@
+package aoc
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// progressDelay keeps fast parts from flashing a progress bar.
+	progressDelay = 250 * time.Millisecond
+	// progressRefresh is the refresh rate of the progress bar on a terminal.
+	progressRefresh = 100 * time.Millisecond
+	// progressInterval is the time between progress logs when not on a terminal.
+	progressInterval = 5 * time.Second
+	progressWidth    = 30
+)
+
+type progressKey struct{}
+
+// Tracker counts the progress of a solver. A nil *Tracker ignores all calls,
+// so solvers can report progress whether the runner tracks it or not.
+type Tracker struct {
+	done  atomic.Int64
+	total atomic.Int64
+}
+
+// Progress returns the tracker the runner added to the context of a solver.
+// It returns nil outside the runner, which is safe to use.
+func Progress(ctx context.Context) *Tracker {
+	t, _ := ctx.Value(progressKey{}).(*Tracker)
+	return t
+}
+
+func withProgress(ctx context.Context, t *Tracker) context.Context {
+	return context.WithValue(ctx, progressKey{}, t)
+}
+
+// Add records n more units of work as done.
+func (t *Tracker) Add(n int) {
+	if t == nil {
+		return
+	}
+	t.done.Add(int64(n))
+}
+
+// SetTotal sets the expected units of work, zero for unknown.
+func (t *Tracker) SetTotal(n int) {
+	if t == nil {
+		return
+	}
+	t.total.Store(int64(n))
+}
+
+// Value returns the work done and the total.
+func (t *Tracker) Value() (done, total int64) {
+	if t == nil {
+		return 0, 0
+	}
+	return t.done.Load(), t.total.Load()
+}
+
+func (t *Tracker) started() bool {
+	done, total := t.Value()
+	return done > 0 || total > 0
+}
+
+// renderProgress shows the progress of t until stop is closed: as a bar
+// labeled label when out is a terminal, otherwise as periodic log lines.
+func renderProgress(out io.Writer, log *slog.Logger, label string, t *Tracker, stop <-chan struct{}) {
+	start := time.Now()
+
+	refresh := progressInterval
+	if isTerminal(out) {
+		refresh = progressRefresh
+	}
+
+	ticker := time.NewTicker(refresh)
+	defer ticker.Stop()
+
+	var drawn bool
+	for {
+		select {
+		case <-stop:
+			if drawn {
+				_, _ = fmt.Fprint(out, "\r\033[K")
+			}
+			return
+		case <-ticker.C:
+		}
+
+		elapsed := time.Since(start)
+		if elapsed < progressDelay || !t.started() {
+			continue
+		}
+
+		done, total := t.Value()
+
+		if refresh == progressInterval {
+			attrs := []any{slog.Int64("Done", done), slog.Duration("Elapsed", elapsed.Round(time.Second))}
+			if total > 0 {
+				attrs = append(attrs, slog.Int64("Total", total))
+			}
+			log.Info("Progress", attrs...)
+			continue
+		}
+
+		_, _ = fmt.Fprintf(out, "\r\033[K%s %s", label, formatProgress(done, total, elapsed))
+		drawn = true
+	}
+}
+
+// formatProgress renders a bar when the total is known, otherwise a counter.
+func formatProgress(done, total int64, elapsed time.Duration) string {
+	elapsed = elapsed.Round(100 * time.Millisecond)
+
+	if total <= 0 {
+		return fmt.Sprintf("%d %s", done, elapsed)
+	}
+
+	frac := min(float64(done)/float64(total), 1)
+	filled := int(frac * progressWidth)
+
+	bar := strings.Repeat("=", filled) + strings.Repeat(" ", progressWidth-filled)
+
+	return fmt.Sprintf("[%s] %3.0f%% %d/%d %s", bar, frac*100, done, total, elapsed)
+}
+
+func isTerminal(w io.Writer) bool {
+	f, ok := w.(*os.File)
+	if !ok {
+		return false
+	}
+
+	fi, err := f.Stat()
+	if err != nil {
+		return false
+	}
+
+	return fi.Mode()&os.ModeCharDevice != 0
+}
@@ -0,0 +1,42 @@
+package aoc
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestProgress(t *testing.T) {
+	// outside the runner the tracker is nil and ignores all calls
+	Progress(context.Background()).SetTotal(10)
+	Progress(context.Background()).Add(1)
+
+	tracker := new(Tracker)
+	p := Progress(withProgress(context.Background(), tracker))
+	p.SetTotal(10)
+	p.Add(3)
+	p.Add(2)
+
+	if done, total := tracker.Value(); done != 5 || total != 10 {
+		t.Errorf("Value() = %d, %d, want 5, 10", done, total)
+	}
+}
+
+func Test_formatProgress(t *testing.T) {
+	tests := []struct {
+		name        string
+		done, total int64
+		want        string
+	}{
+		{"unknown total", 1234, 0, "1234 1.5s"},
+		{"half", 5, 10, "[===============               ]  50% 5/10 1.5s"},
+		{"overflow", 12, 10, "[==============================] 100% 12/10 1.5s"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := formatProgress(tt.done, tt.total, 1500*time.Millisecond); got != tt.want {
+				t.Errorf("formatProgress() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,176 @@
+package aoc
+
+import (
+	"fmt"
+	"html"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// Puzzle is the description of a challenge as shown on the site.
+type Puzzle struct {
+	// Title is the title of the puzzle, without the surrounding dashes.
+	Title string
+	// Markdown is the description of all unlocked parts.
+	Markdown string
+	// Examples holds the contents of the code blocks in the description.
+	Examples []string
+}
+
+var (
+	descReg  = regexp.MustCompile(`(?s)<article class="day-desc">(.*?)</article>`)
+	titleReg = regexp.MustCompile(`--- Day \d+: (.*?) ---`)
+	tokenReg = regexp.MustCompile(`<(/?)([a-zA-Z0-9]+)([^>]*)>`)
+	hrefReg  = regexp.MustCompile(`href="([^"]*)"`)
+)
+
+// parsePuzzle extracts the puzzle description from the puzzle page.
+// Relative links are resolved against base.
+func parsePuzzle(page string, base *url.URL) (*Puzzle, error) {
+	articles := descReg.FindAllStringSubmatch(page, -1)
+	if len(articles) == 0 {
+		return nil, fmt.Errorf("%w: no puzzle description", ErrUnknownResponse)
+	}
+
+	var puzzle Puzzle
+	var parts []string
+	for _, article := range articles {
+		md, examples := toMarkdown(article[1], base)
+		parts = append(parts, md)
+		puzzle.Examples = append(puzzle.Examples, examples...)
+	}
+
+	puzzle.Markdown = strings.Join(parts, "\n")
+
+	if m := titleReg.FindStringSubmatch(puzzle.Markdown); m != nil {
+		puzzle.Title = m[1]
+	}
+
+	return &puzzle, nil
+}
+
+// toMarkdown converts the limited HTML used in puzzle descriptions to Markdown.
+// It returns the contents of the code blocks separately.
+func toMarkdown(s string, base *url.URL) (string, []string) {
+	var (
+		sb       strings.Builder
+		examples []string
+		pre      strings.Builder
+		inPre    bool
+		inCode   bool
+		hrefs    []string
+	)
+
+	text := func(t string) {
+		t = html.UnescapeString(t)
+		if inPre {
+			pre.WriteString(t)
+			return
+		}
+		sb.WriteString(strings.ReplaceAll(t, "\n", " "))
+	}
+
+	last := 0
+	for _, m := range tokenReg.FindAllStringSubmatchIndex(s, -1) {
+		text(s[last:m[0]])
+		last = m[1]
+
+		closing := m[3] > m[2]
+		tag := strings.ToLower(s[m[4]:m[5]])
+		attrs := s[m[6]:m[7]]
+
+		switch {
+		case tag == "pre" && !closing:
+			inPre = true
+			pre.Reset()
+		case tag == "pre" && closing:
+			inPre = false
+			example := pre.String()
+			examples = append(examples, example)
+			sb.WriteString("```\n" + strings.TrimSuffix(example, "\n") + "\n```\n\n")
+		case inPre:
+			// formatting inside code blocks cannot be represented
+		case tag == "h2" && !closing:
+			sb.WriteString("## ")
+		case tag == "h2", tag == "p", tag == "ul":
+			if closing {
+				sb.WriteString("\n\n")
+			}
+		case tag == "li" && !closing:
+			sb.WriteString("- ")
+		case tag == "li":
+			sb.WriteString("\n")
+		case tag == "code":
+			inCode = !closing
+			sb.WriteString("`")
+		case tag == "em" && !inCode:
+			sb.WriteString("**")
+		case tag == "a" && !closing:
+			href := ""
+			if h := hrefReg.FindStringSubmatch(attrs); h != nil {
+				href = html.UnescapeString(h[1])
+				if u, err := base.Parse(href); err == nil {
+					href = u.String()
+				}
+			}
+			hrefs = append(hrefs, href)
+			sb.WriteString("[")
+		case tag == "a" && len(hrefs) > 0:
+			sb.WriteString("](" + hrefs[len(hrefs)-1] + ")")
+			hrefs = hrefs[:len(hrefs)-1]
+		}
+	}
+	text(s[last:])
+
+	return tidy(sb.String()), examples
+}
+
+// tidy trims the lines outside code blocks and collapses consecutive blank lines.
+func tidy(md string) string {
+	var lines []string
+	fenced := false
+	for _, line := range strings.Split(md, "\n") {
+		if strings.TrimSpace(line) == "```" {
+			fenced = !fenced
+			lines = append(lines, "```")
+			continue
+		}
+
+		if !fenced {
+			line = strings.TrimSpace(line)
+			if line == "" && (len(lines) == 0 || lines[len(lines)-1] == "") {
+				continue
+			}
+		}
+
+		lines = append(lines, line)
+	}
+
+	return strings.TrimSpace(strings.Join(lines, "\n")) + "\n"
+}
+
+func problemReadme(year, day int) (string, error) {
+	dir, err := problemDir(year, day)
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dir, "README.md"), nil
+}
+
+// StorePuzzle writes the puzzle description to README.md in the challenge directory.
+func (p Challenge) StorePuzzle(puzzle *Puzzle) error {
+	fp, err := problemReadme(p.Year, p.Day)
+	if err != nil {
+		return err
+	}
+
+	if err = os.MkdirAll(filepath.Dir(fp), 0755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(fp, []byte(puzzle.Markdown), 0644)
+}
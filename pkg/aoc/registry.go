@@ -0,0 +1,162 @@
+package aoc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+var ErrNoSolution = errors.New("no solution registered")
+
+var ErrNoPart = errors.New("part not solved")
+
+var registry = struct {
+	sync.Mutex
+	solutions map[Challenge]*Solution
+}{
+	solutions: make(map[Challenge]*Solution),
+}
+
+// Solution is a registered solution for a single challenge.
+type Solution struct {
+	Challenge Challenge
+	parts     int
+	runner    func(opts ...Option) DayRunner
+}
+
+// DayRunner runs the parts of a registered solution on a single parsed input.
+type DayRunner interface {
+	// Parse loads and parses the input once.
+	Parse() (Stats, error)
+	// InputName names the input the parts are solved for.
+	InputName() string
+	// Solve solves the given part (1-based) repeat times.
+	Solve(ctx context.Context, part, repeat int) (string, Stats, error)
+}
+
+type dayRunner[T any] struct {
+	challenge Challenge
+	runner    *Runner[T]
+	parts     []ContextSolverFunc[T]
+}
+
+func (d *dayRunner[T]) Parse() (Stats, error) {
+	return d.runner.Parse()
+}
+
+func (d *dayRunner[T]) InputName() string {
+	return d.runner.InputName()
+}
+
+func (d *dayRunner[T]) Solve(ctx context.Context, part, repeat int) (string, Stats, error) {
+	if part < 1 || part > len(d.parts) {
+		return "", Stats{}, fmt.Errorf("%s part %d: %w", d.challenge, part, ErrNoPart)
+	}
+
+	answer, stats, err := d.runner.solve(ctx, fmt.Sprintf("part%d", part), d.parts[part-1], repeat)
+
+	if d.runner.reporter != nil {
+		var parsed Stats
+		if d.runner.parsed != nil {
+			parsed = *d.runner.parsed
+		}
+
+		report := NewReport(d.challenge, d.runner.InputName(), part, answer, parsed, stats, err)
+		if rerr := d.runner.reporter.Report(report); rerr != nil && err == nil {
+			err = fmt.Errorf("report: %w", rerr)
+		}
+	}
+
+	return answer, stats, err
+}
+
+// Register adds the solution for a challenge to the registry.
+// It is meant to be called from the init function of a day's package.
+// A nil part2 marks a day that only has one part, such as day 25.
+func Register[T any](year, day int, parser ParserFunc[T], part1, part2 SolverFunc[T]) {
+	RegisterContext(year, day, parser, part1.Context(), part2.Context())
+}
+
+// RegisterContext is Register for solvers that take a context and return an error.
+// It panics when part1 is nil, part2 would then be solved as part 1.
+func RegisterContext[T any](year, day int, parser ParserFunc[T], part1, part2 ContextSolverFunc[T]) {
+	c := NewChallenge(year, day)
+
+	if part1 == nil {
+		panic(fmt.Sprintf("aoc: challenge %s registered without part 1", c))
+	}
+
+	parts := []ContextSolverFunc[T]{part1}
+	if part2 != nil {
+		parts = append(parts, part2)
+	}
+
+	s := &Solution{
+		Challenge: c,
+		parts:     len(parts),
+		runner: func(opts ...Option) DayRunner {
+			return &dayRunner[T]{challenge: c, runner: New(year, day, parser, opts...), parts: parts}
+		},
+	}
+
+	registry.Lock()
+	defer registry.Unlock()
+
+	if _, ok := registry.solutions[c]; ok {
+		panic(fmt.Sprintf("aoc: challenge %s registered twice", c))
+	}
+
+	registry.solutions[c] = s
+}
+
+// Lookup returns the solution registered for the given year and day.
+func Lookup(year, day int) (*Solution, error) {
+	registry.Lock()
+	defer registry.Unlock()
+
+	s, ok := registry.solutions[NewChallenge(year, day)]
+	if !ok {
+		return nil, fmt.Errorf("%s: %w", NewChallenge(year, day), ErrNoSolution)
+	}
+
+	return s, nil
+}
+
+// Solutions returns all registered solutions ordered by year and day.
+func Solutions() []*Solution {
+	registry.Lock()
+	defer registry.Unlock()
+
+	solutions := make([]*Solution, 0, len(registry.solutions))
+	for _, s := range registry.solutions {
+		solutions = append(solutions, s)
+	}
+
+	sort.Slice(solutions, func(i, j int) bool {
+		return solutions[i].Challenge.Less(solutions[j].Challenge)
+	})
+
+	return solutions
+}
+
+// Parts returns the number of parts this solution solves.
+func (s *Solution) Parts() int {
+	return s.parts
+}
+
+// Runner returns a runner that parses the input once for all parts.
+func (s *Solution) Runner(opts ...Option) DayRunner {
+	return s.runner(opts...)
+}
+
+// Run solves the given part (1-based) of the challenge.
+func (s *Solution) Run(part int, opts ...Option) (string, error) {
+	if part < 1 || part > s.parts {
+		return "", fmt.Errorf("%s part %d: %w", s.Challenge, part, ErrNoPart)
+	}
+
+	answer, _, err := s.Runner(opts...).Solve(context.Background(), part, 1)
+	return answer, err
+}
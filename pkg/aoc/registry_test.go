@@ -0,0 +1,50 @@
+package aoc
+
+import (
+	"errors"
+	"io"
+	"testing"
+)
+
+func TestRegister(t *testing.T) {
+	parse := func(io.Reader) (int, error) { return 0, nil }
+	solve := func(int) string { return "0" }
+
+	Register(1999, 2, parse, solve, solve)
+	Register(1999, 1, parse, solve, nil)
+
+	one, err := Lookup(1999, 1)
+	if err != nil {
+		t.Fatalf("Lookup() error = %v", err)
+	}
+	if got := one.Parts(); got != 1 {
+		t.Errorf("Parts() = %d, want 1", got)
+	}
+	if _, err = one.Run(2); !errors.Is(err, ErrNoPart) {
+		t.Errorf("Run(2) error = %v, want %v", err, ErrNoPart)
+	}
+
+	if _, err = Lookup(1999, 3); !errors.Is(err, ErrNoSolution) {
+		t.Errorf("Lookup() error = %v, want %v", err, ErrNoSolution)
+	}
+
+	var days []int
+	for _, s := range Solutions() {
+		if s.Challenge.Year == 1999 {
+			days = append(days, s.Challenge.Day)
+		}
+	}
+	if len(days) != 2 || days[0] != 1 || days[1] != 2 {
+		t.Errorf("Solutions() days = %v, want [1 2]", days)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Register() without part 1 did not panic")
+		}
+		if _, err := Lookup(1999, 3); !errors.Is(err, ErrNoSolution) {
+			t.Errorf("Lookup() of a day without part 1 error = %v, want %v", err, ErrNoSolution)
+		}
+	}()
+	Register(1999, 3, parse, nil, solve)
+}
@@ -0,0 +1,233 @@
+package aoc
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Report is the structured outcome of solving one part.
+type Report struct {
+	ID      string  `json:"id"`
+	Year    int     `json:"year"`
+	Day     int     `json:"day"`
+	Input   string  `json:"input"`
+	Part    int     `json:"part"`
+	Answer  string  `json:"answer"`
+	Want    string  `json:"want,omitempty"`
+	Verdict Verdict `json:"verdict"`
+	Error   string  `json:"error,omitempty"`
+	Parse   Stats   `json:"parse"`
+	Solve   Stats   `json:"solve"`
+}
+
+// NewReport creates the report of a solved part. Answers to the default input
+// are verified against the accepted answers of the challenge, answers to other
+// inputs are Missing.
+func NewReport(c Challenge, input string, part int, answer string, parse, solve Stats, err error) Report {
+	r := Report{
+		ID:     c.String(),
+		Year:   c.Year,
+		Day:    c.Day,
+		Input:  input,
+		Part:   part,
+		Answer: answer,
+		Parse:  parse,
+		Solve:  solve,
+	}
+
+	if err != nil {
+		r.Verdict = Error
+		r.Error = err.Error()
+		return r
+	}
+
+	if input != DefaultInput {
+		r.Verdict = Missing
+		return r
+	}
+
+	answers, err := c.Answers()
+	if err != nil {
+		r.Verdict = Error
+		r.Error = err.Error()
+		return r
+	}
+
+	r.Want, _ = answers.Get(part)
+	r.Verdict = answers.Verify(part, answer)
+
+	return r
+}
+
+// Reporter receives the report of every solved part.
+// Close must be called once all parts are reported.
+type Reporter interface {
+	Report(Report) error
+	Close() error
+}
+
+// NewJSONReporter writes every report as a line of JSON.
+func NewJSONReporter(w io.Writer) Reporter {
+	return &jsonReporter{enc: json.NewEncoder(w)}
+}
+
+type jsonReporter struct {
+	mu  sync.Mutex
+	enc *json.Encoder
+}
+
+func (r *jsonReporter) Report(report Report) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.enc.Encode(report)
+}
+
+func (r *jsonReporter) Close() error {
+	return nil
+}
+
+// NewJUnitReporter writes the reports as JUnit XML, with a test suite per
+// challenge and a test case per part. The XML is written on Close.
+func NewJUnitReporter(w io.Writer) Reporter {
+	return &junitReporter{w: w}
+}
+
+type junitReporter struct {
+	mu      sync.Mutex
+	w       io.Writer
+	reports []Report
+}
+
+func (r *junitReporter) Report(report Report) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.reports = append(r.reports, report)
+	return nil
+}
+
+type junitSuites struct {
+	XMLName xml.Name     `xml:"testsuites"`
+	Suites  []junitSuite `xml:"testsuite"`
+}
+
+type junitSuite struct {
+	Name     string      `xml:"name,attr"`
+	Tests    int         `xml:"tests,attr"`
+	Failures int         `xml:"failures,attr"`
+	Errors   int         `xml:"errors,attr"`
+	Skipped  int         `xml:"skipped,attr"`
+	Time     seconds     `xml:"time,attr"`
+	Cases    []junitCase `xml:"testcase"`
+}
+
+type junitCase struct {
+	Name      string        `xml:"name,attr"`
+	Classname string        `xml:"classname,attr"`
+	Time      seconds       `xml:"time,attr"`
+	Failure   *junitMessage `xml:"failure"`
+	Error     *junitMessage `xml:"error"`
+	Skipped   *junitMessage `xml:"skipped"`
+	SystemOut string        `xml:"system-out,omitempty"`
+}
+
+// seconds formats a duration the way JUnit expects it.
+type seconds time.Duration
+
+func (s seconds) MarshalText() ([]byte, error) {
+	return []byte(fmt.Sprintf("%.6f", time.Duration(s).Seconds())), nil
+}
+
+type junitMessage struct {
+	Message string `xml:"message,attr"`
+	Text    string `xml:",chardata"`
+}
+
+func (r *junitReporter) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// reports of concurrent runs arrive in any order
+	sort.SliceStable(r.reports, func(i, j int) bool {
+		a, b := r.reports[i], r.reports[j]
+		if a.ID != b.ID {
+			return a.ID < b.ID
+		}
+		if a.Input != b.Input {
+			return a.Input < b.Input
+		}
+		return a.Part < b.Part
+	})
+
+	var doc junitSuites
+	index := make(map[string]int)
+
+	for _, report := range r.reports {
+		name := report.ID
+		if report.Input != DefaultInput {
+			name += " " + report.Input
+		}
+
+		i, ok := index[name]
+		if !ok {
+			i = len(doc.Suites)
+			index[name] = i
+			doc.Suites = append(doc.Suites, junitSuite{Name: name, Time: seconds(report.Parse.Median)})
+		}
+		suite := &doc.Suites[i]
+
+		tc := junitCase{
+			Name:      fmt.Sprintf("part%d", report.Part),
+			Classname: "aoc." + report.ID,
+			Time:      seconds(report.Solve.Median),
+			SystemOut: report.Answer,
+		}
+
+		switch report.Verdict {
+		case Fail:
+			tc.Failure = &junitMessage{Message: "wrong answer", Text: fmt.Sprintf("got %s, want %s", report.Answer, report.Want)}
+			suite.Failures++
+		case Error:
+			tc.Error = &junitMessage{Message: report.Error}
+			suite.Errors++
+		case Missing:
+			tc.Skipped = &junitMessage{Message: "no accepted answer"}
+			suite.Skipped++
+		}
+
+		suite.Tests++
+		suite.Time += tc.Time
+		suite.Cases = append(suite.Cases, tc)
+	}
+
+	if _, err := io.WriteString(r.w, xml.Header); err != nil {
+		return err
+	}
+
+	enc := xml.NewEncoder(r.w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		return err
+	}
+
+	_, err := io.WriteString(r.w, "\n")
+	return err
+}
+
+// NewReporter returns the reporter for a format, json (lines) or junit.
+func NewReporter(w io.Writer, format string) (Reporter, error) {
+	switch format {
+	case "json":
+		return NewJSONReporter(w), nil
+	case "junit":
+		return NewJUnitReporter(w), nil
+	default:
+		return nil, fmt.Errorf("unknown report format %q", format)
+	}
+}
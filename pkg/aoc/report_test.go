@@ -0,0 +1,67 @@
+package aoc
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestJSONReporter(t *testing.T) {
+	var buf bytes.Buffer
+
+	r := NewJSONReporter(&buf)
+	for part := 1; part <= 2; part++ {
+		if err := r.Report(Report{ID: "2016-01", Year: 2016, Day: 1, Part: part, Verdict: Pass}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := r.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("got %d lines, want 2:\n%s", len(lines), buf.String())
+	}
+	if !strings.Contains(lines[1], `"part":2`) {
+		t.Errorf("line 2 = %s, want part 2", lines[1])
+	}
+}
+
+func TestJUnitReporter(t *testing.T) {
+	reports := []Report{
+		{ID: "2016-01", Input: DefaultInput, Part: 1, Answer: "12", Want: "12", Verdict: Pass, Parse: Stats{Median: time.Millisecond}, Solve: Stats{Median: 2 * time.Millisecond}},
+		{ID: "2016-01", Input: DefaultInput, Part: 2, Answer: "3", Want: "4", Verdict: Fail, Parse: Stats{Median: time.Millisecond}, Solve: Stats{Median: time.Millisecond}},
+		{ID: "2016-02", Input: DefaultInput, Part: 1, Verdict: Error, Error: "parse: unexpected EOF"},
+		{ID: "2016-02", Input: DefaultInput, Part: 2, Answer: "5", Verdict: Missing},
+		{ID: "2016-02", Input: "example1.txt", Part: 1, Answer: "7", Verdict: Missing},
+	}
+
+	var buf bytes.Buffer
+
+	r := NewJUnitReporter(&buf)
+	for _, report := range reports {
+		if err := r.Report(report); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := r.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	got := buf.String()
+	for _, want := range []string{
+		`<testsuite name="2016-01" tests="2" failures="1" errors="0" skipped="0" time="0.004000">`,
+		`<testcase name="part1" classname="aoc.2016-01" time="0.002000">`,
+		`<failure message="wrong answer">got 3, want 4</failure>`,
+		`<testsuite name="2016-02" tests="2" failures="0" errors="1" skipped="1" time="0.000000">`,
+		`<error message="parse: unexpected EOF"></error>`,
+		`<skipped message="no accepted answer"></skipped>`,
+		`<testsuite name="2016-02 example1.txt" tests="1" failures="0" errors="0" skipped="1" time="0.000000">`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("report does not contain %s:\n%s", want, got)
+		}
+	}
+}
@@ -1,13 +1,19 @@
 package aoc
 
 import (
+	"bytes"
+	"context"
+	"errors"
 	"fmt"
 	"io"
 	"log/slog"
 	"os"
+	"runtime/debug"
 	"time"
 )
 
+var ErrTimeout = errors.New("part timed out")
+
 var _ Parser[int] = ParserFunc[int](nil)
 
 type ParserFunc[T any] func(io.Reader) (T, error)
@@ -26,14 +32,55 @@ type Parser[Input any] interface {
 
 type SolverFunc[Input any] func(Input) string
 
+// ContextSolverFunc is a solver that can be cancelled and can fail.
+type ContextSolverFunc[Input any] func(context.Context, Input) (string, error)
+
+// Context adapts f to a ContextSolverFunc. A nil f stays nil.
+func (f SolverFunc[Input]) Context() ContextSolverFunc[Input] {
+	if f == nil {
+		return nil
+	}
+	return func(_ context.Context, input Input) (string, error) {
+		return f(input), nil
+	}
+}
+
 type Runner[Input any] struct {
-	challenge Challenge
-	parser    Parser[Input]
-	input     Input
+	challenge   Challenge
+	parser      Parser[Input]
+	input       Input
+	parsed      *Stats // set once the input is parsed
+	parseErr    error
+	abandoned   bool // a solver that timed out may still use input
+	offline     bool
+	timeout     time.Duration
+	reporter    Reporter
+	source      *source // nil for the default input
+	out         io.Writer
+	log         *slog.Logger
+	profiles    []Profile
+	profilePart int
+	ocr         OCRFunc
+}
+
+// read returns the normalized input of the runner.
+func (d *Runner[Input]) read() (io.Reader, error) {
+	if d.source == nil {
+		return d.challenge.input(d.offline)
+	}
+	return d.source.read(d.challenge)
+}
+
+// InputName names the input of the runner, see the input options.
+func (d *Runner[Input]) InputName() string {
+	if d.source == nil {
+		return DefaultInput
+	}
+	return d.source.name
 }
 
 func (d *Runner[Input]) load() (Input, error) {
-	reader, err := d.challenge.Input()
+	reader, err := d.read()
 	if err != nil {
 		var zero Input
 		return zero, fmt.Errorf("get: %w", err)
@@ -48,20 +95,166 @@ func (d *Runner[Input]) load() (Input, error) {
 	return input, nil
 }
 
-func (d *Runner[Input]) Run(solve SolverFunc[Input]) (string, error) {
-	start := time.Now()
+// Parse loads and parses the input. The input is parsed only once, later
+// calls return the statistics or error of the first call.
+func (d *Runner[Input]) Parse() (Stats, error) {
+	if d.parseErr != nil {
+		return Stats{}, d.parseErr
+	}
+	if d.parsed != nil {
+		return *d.parsed, nil
+	}
+
+	var err error
+	m := measure(func() {
+		err = catch(func() (err error) {
+			d.input, err = d.load()
+			return err
+		})
+	})
+	if err != nil {
+		d.parseErr = err
+		return Stats{}, err
+	}
+
+	stats := summarize([]Measurement{m})
+	d.parsed = &stats
+
+	d.log.Info("Parsed", slog.String("Input", d.InputName()), slog.Any("Stats", stats))
+
+	return stats, nil
+}
+
+// Solve solves the parsed input repeat times and reports the statistics of all runs.
+func (d *Runner[Input]) Solve(solve SolverFunc[Input], repeat int) (string, Stats, error) {
+	return d.SolveContext(context.Background(), solve.Context(), repeat)
+}
+
+// SolveContext is Solve for solvers that take a context. Every run is limited
+// by the timeout of the runner, if any. Panics are returned as a *PanicError.
+func (d *Runner[Input]) SolveContext(ctx context.Context, solve ContextSolverFunc[Input], repeat int) (string, Stats, error) {
+	return d.solve(ctx, "solve", solve, repeat)
+}
+
+// solve implements SolveContext, name identifies the part in logs and profiles.
+func (d *Runner[Input]) solve(ctx context.Context, name string, solve ContextSolverFunc[Input], repeat int) (string, Stats, error) {
+	if _, err := d.Parse(); err != nil {
+		return "", Stats{}, err
+	}
+
+	if d.abandoned {
+		// the abandoned solver may still change the input, give this one its own
+		if err := d.reload(); err != nil {
+			return "", Stats{}, err
+		}
+	}
+
+	log := d.log.With(slog.String("Part", name))
+	ctx = withLogger(ctx, log)
+	if d.ocr != nil {
+		ctx = withOCR(ctx, d.ocr)
+	}
+
+	var result string
+	ms := make([]Measurement, max(repeat, 1))
+
+	err := profile(log, d.challenge, name, d.profilesOf(name), func() error {
+		for i := range ms {
+			var err error
+			ms[i] = measure(func() {
+				result, err = d.call(ctx, log, name, solve)
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return "", Stats{}, err
+	}
+
+	stats := summarize(ms)
+
+	log.Info("Solved", slog.Any("Stats", stats))
+
+	return result, stats, nil
+}
+
+// reload parses a fresh input after a solver was abandoned.
+func (d *Runner[Input]) reload() error {
+	return catch(func() (err error) {
+		input, err := d.load()
+		if err != nil {
+			return err
+		}
+		d.input, d.abandoned = input, false
+		return nil
+	})
+}
+
+// call runs solve in its own goroutine, so solvers that ignore the context
+// are abandoned when it is done. Abandoned solvers keep running until they
+// return, so the next call gets a fresh input. The progress of the solver is
+// rendered to the output of the runner.
+func (d *Runner[Input]) call(ctx context.Context, log *slog.Logger, name string, solve ContextSolverFunc[Input]) (string, error) {
+	if d.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeoutCause(ctx, d.timeout, fmt.Errorf("%w after %s", ErrTimeout, d.timeout))
+		defer cancel()
+	}
+
+	tracker := new(Tracker)
+	ctx = withProgress(ctx, tracker)
+
+	stop, rendered := make(chan struct{}), make(chan struct{})
+	go func() {
+		defer close(rendered)
+		renderProgress(d.out, log, d.challenge.String()+" "+name, tracker, stop)
+	}()
 	defer func() {
-		slog.Info("Finished", slog.Duration("Duration", time.Since(start)))
+		close(stop)
+		<-rendered
 	}()
 
-	in, err := d.load()
-	if err != nil {
-		return "", err
+	type result struct {
+		answer string
+		err    error
 	}
 
-	result := solve(in)
+	input := d.input
+
+	done := make(chan result, 1)
+	go func() {
+		var r result
+		r.err = catch(func() (err error) {
+			r.answer, err = solve(ctx, input)
+			return err
+		})
+		done <- r
+	}()
+
+	select {
+	case r := <-done:
+		if r.err != nil && ctx.Err() != nil {
+			// the solver gave up because of the context, report why
+			return "", context.Cause(ctx)
+		}
+		return r.answer, r.err
+	case <-ctx.Done():
+		select {
+		case <-done:
+		default:
+			d.abandoned = true
+			log.Warn("Abandoned solver, it keeps running and its work counts towards the statistics of the next parts")
+		}
+		return "", context.Cause(ctx)
+	}
+}
 
-	return result, nil
+func (d *Runner[Input]) Run(solve SolverFunc[Input]) (string, error) {
+	result, _, err := d.Solve(solve, 1)
+	return result, err
 }
 
 func (d *Runner[Input]) String() string {
@@ -74,9 +267,31 @@ func New[T any](year, day int, parser ParserFunc[T], opts ...Option) *Runner[T]
 		opt(o)
 	}
 
-	th := slog.NewTextHandler(o.out, &slog.HandlerOptions{
+	runner := &Runner[T]{
+		challenge: Challenge{
+			Year: year,
+			Day:  day,
+		},
+		parser:      parser,
+		offline:     o.offline || Offline(),
+		timeout:     o.timeout,
+		reporter:    o.reporter,
+		source:      o.source,
+		out:         o.out,
+		log:         o.logger().With(slog.String("Challenge", NewChallenge(year, day).String())),
+		profiles:    o.profiles,
+		profilePart: o.profilePart,
+		ocr:         o.ocr,
+	}
+
+	return runner
+}
+
+// NewLogHandler returns the handler the runner logs with: text with short timestamps.
+func NewLogHandler(out io.Writer, level slog.Leveler) slog.Handler {
+	return slog.NewTextHandler(out, &slog.HandlerOptions{
 		AddSource: false,
-		Level:     o.logLevel,
+		Level:     level,
 		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
 			switch a.Key {
 			case "time":
@@ -89,17 +304,6 @@ func New[T any](year, day int, parser ParserFunc[T], opts ...Option) *Runner[T]
 			return a
 		},
 	})
-	slog.SetDefault(slog.New(th))
-
-	runner := &Runner[T]{
-		challenge: Challenge{
-			Year: year,
-			Day:  day,
-		},
-		parser: parser,
-	}
-
-	return runner
 }
 
 type Option func(*options)
@@ -116,9 +320,121 @@ func WithLogLevel(level slog.Level) Option {
 	}
 }
 
+// WithOffline never downloads missing inputs, see Offline.
+func WithOffline() Option {
+	return func(o *options) {
+		o.offline = true
+	}
+}
+
+// WithReporter sends the report of every part solved through the registry to r.
+func WithReporter(r Reporter) Option {
+	return func(o *options) {
+		o.reporter = r
+	}
+}
+
+// WithTimeout limits the time a part may take, zero means no limit.
+func WithTimeout(d time.Duration) Option {
+	return func(o *options) {
+		o.timeout = d
+	}
+}
+
+// source is an input other than the default input of a challenge.
+type source struct {
+	name string
+	read func(Challenge) (io.Reader, error)
+}
+
+// WithInputFile reads the input from a file instead of input.txt.
+func WithInputFile(path string) Option {
+	return func(o *options) {
+		o.source = &source{
+			name: path,
+			read: func(Challenge) (io.Reader, error) {
+				b, err := os.ReadFile(path)
+				if err != nil {
+					return nil, err
+				}
+				return bytes.NewReader(NormalizeInput(b)), nil
+			},
+		}
+	}
+}
+
+// WithInputString uses s as the input, such as the example of a puzzle.
+func WithInputString(s string) Option {
+	return func(o *options) {
+		o.source = &source{
+			name: "string",
+			read: func(Challenge) (io.Reader, error) {
+				return bytes.NewReader(NormalizeInput([]byte(s))), nil
+			},
+		}
+	}
+}
+
+// WithNamedInput reads one of the inputs stored with the challenge, see
+// Challenge.NamedInput. The name input selects the default input.
+func WithNamedInput(name string) Option {
+	return func(o *options) {
+		if inputFile(name) == DefaultInput {
+			o.source = nil
+			return
+		}
+
+		o.source = &source{
+			name: inputFile(name),
+			read: func(c Challenge) (io.Reader, error) {
+				return c.NamedInput(name)
+			},
+		}
+	}
+}
+
+// WithLogger logs to l instead of a text logger on the output of the runner.
+// WithOutput and WithLogLevel no longer apply to the logs.
+func WithLogger(l *slog.Logger) Option {
+	return func(o *options) {
+		o.log = l
+	}
+}
+
 type options struct {
-	out      io.Writer
-	logLevel slog.Level
+	out         io.Writer
+	log         *slog.Logger
+	logLevel    slog.Level
+	offline     bool
+	timeout     time.Duration
+	reporter    Reporter
+	source      *source
+	profiles    []Profile
+	profilePart int
+	ocr         OCRFunc
+}
+
+func (o *options) logger() *slog.Logger {
+	if o.log != nil {
+		return o.log
+	}
+	return slog.New(NewLogHandler(o.out, o.logLevel))
+}
+
+type loggerKey struct{}
+
+func withLogger(ctx context.Context, l *slog.Logger) context.Context {
+	return context.WithValue(ctx, loggerKey{}, l)
+}
+
+// Logger returns the logger the runner passes to context solvers, which adds
+// the challenge and part to every record. Outside the runner it returns the
+// default logger.
+func Logger(ctx context.Context) *slog.Logger {
+	if l, ok := ctx.Value(loggerKey{}).(*slog.Logger); ok {
+		return l
+	}
+	return slog.Default()
 }
 
 func defaults() *options {
@@ -128,6 +444,27 @@ func defaults() *options {
 	}
 }
 
+// PanicError is a panic recovered from a parser or solver.
+type PanicError struct {
+	Value any
+	Stack []byte
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("panic: %v", e.Value)
+}
+
+// catch returns the error of fn, turning a panic into a *PanicError.
+func catch(fn func() error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = &PanicError{Value: r, Stack: debug.Stack()}
+		}
+	}()
+
+	return fn()
+}
+
 func Must[T any](result T, err error) T {
 	if err != nil {
 		panic(err)
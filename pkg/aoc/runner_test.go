@@ -0,0 +1,205 @@
+package aoc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRunner_SolveContext(t *testing.T) {
+	tests := []struct {
+		name    string
+		timeout time.Duration
+		solve   ContextSolverFunc[int]
+		want    string
+		wantErr error
+	}{
+		{
+			name:  "answer",
+			solve: func(_ context.Context, n int) (string, error) { return Result(n * 2), nil },
+			want:  "42",
+		},
+		{
+			name:    "timeout",
+			timeout: 10 * time.Millisecond,
+			solve: func(ctx context.Context, _ int) (string, error) {
+				<-ctx.Done()
+				return "", ctx.Err()
+			},
+			wantErr: ErrTimeout,
+		},
+		{
+			name:    "ignores context",
+			timeout: 10 * time.Millisecond,
+			solve: func(context.Context, int) (string, error) {
+				time.Sleep(time.Second)
+				return "late", nil
+			},
+			wantErr: ErrTimeout,
+		},
+		{
+			name:    "panic",
+			solve:   func(context.Context, int) (string, error) { panic("boom") },
+			wantErr: &PanicError{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Runner[int]{input: 21, parsed: &Stats{}, timeout: tt.timeout, log: slog.New(NewLogHandler(io.Discard, slog.LevelInfo))}
+
+			got, _, err := r.SolveContext(context.Background(), tt.solve, 1)
+
+			var perr *PanicError
+			switch {
+			case tt.wantErr == nil && err != nil:
+				t.Fatalf("SolveContext() error = %v", err)
+			case errors.As(tt.wantErr, &perr):
+				if !errors.As(err, &perr) || !strings.Contains(string(perr.Stack), "runner_test.go") {
+					t.Fatalf("SolveContext() error = %v, want a panic with a stack", err)
+				}
+			case !errors.Is(err, tt.wantErr):
+				t.Fatalf("SolveContext() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Errorf("SolveContext() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRunner_SolveContext_abandoned(t *testing.T) {
+	parse := func(r io.Reader) ([]int, error) {
+		return ParseLines(r, strconv.Atoi)
+	}
+	r := New(2015, 1, parse, WithInputString("1\n2\n3\n"), WithTimeout(10*time.Millisecond), WithLogger(slog.New(slog.NewTextHandler(io.Discard, nil))))
+
+	stop := make(chan struct{})
+	defer close(stop)
+
+	// ignores the context and keeps changing the input
+	mutate := func(_ context.Context, nums []int) (string, error) {
+		for {
+			select {
+			case <-stop:
+				return "", nil
+			default:
+				nums[0]++
+			}
+		}
+	}
+
+	if _, _, err := r.SolveContext(context.Background(), mutate, 1); !errors.Is(err, ErrTimeout) {
+		t.Fatalf("SolveContext() error = %v, want %v", err, ErrTimeout)
+	}
+
+	sum := func(_ context.Context, nums []int) (string, error) {
+		return Result(nums[0] + nums[1] + nums[2]), nil
+	}
+
+	got, _, err := r.SolveContext(context.Background(), sum, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != "6" {
+		t.Errorf("SolveContext() after an abandoned solver = %s, want 6", got)
+	}
+}
+
+func TestRunner_Input(t *testing.T) {
+	parse := func(r io.Reader) (string, error) {
+		b, err := io.ReadAll(r)
+		return string(b), err
+	}
+
+	path := filepath.Join(t.TempDir(), "alice.txt")
+	if err := os.WriteFile(path, []byte("from file\r\n\r\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name      string
+		opt       Option
+		want      string
+		wantInput string
+	}{
+		{
+			name:      "string",
+			opt:       WithInputString("1\r\n2"),
+			want:      "1\n2\n",
+			wantInput: "string",
+		},
+		{
+			name:      "file",
+			opt:       WithInputFile(path),
+			want:      "from file\n",
+			wantInput: path,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := New(1999, 1, parse, WithOutput(io.Discard), tt.opt)
+
+			got, err := r.Run(func(input string) string { return input })
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("Run() = %q, want %q", got, tt.want)
+			}
+			if got := r.InputName(); got != tt.wantInput {
+				t.Errorf("InputName() = %q, want %q", got, tt.wantInput)
+			}
+		})
+	}
+}
+
+func TestWithNamedInput(t *testing.T) {
+	for name, want := range map[string]string{"input": DefaultInput, "input.txt": DefaultInput, "example1": "example1.txt", "input-alice.txt": "input-alice.txt"} {
+		r := New(1999, 1, ParserFunc[int](nil), WithOutput(io.Discard), WithNamedInput(name))
+		if got := r.InputName(); got != want {
+			t.Errorf("WithNamedInput(%q) input = %q, want %q", name, got, want)
+		}
+	}
+}
+
+func TestWithLogger(t *testing.T) {
+	var buf strings.Builder
+	log := slog.New(slog.NewJSONHandler(&buf, nil))
+
+	r := New(1999, 1, func(r io.Reader) (int, error) { return 1, nil }, WithInputString(""), WithLogger(log))
+
+	_, _, err := r.solve(context.Background(), "part1", func(ctx context.Context, input int) (string, error) {
+		Logger(ctx).Info("Solving", slog.Int("Input", input))
+		return "1", nil
+	}, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("got %d log lines, want 3:\n%s", len(lines), buf.String())
+	}
+
+	for i, want := range []string{
+		`"msg":"Parsed","Challenge":"1999-01","Input":"string"`,
+		`"msg":"Solving","Challenge":"1999-01","Part":"part1","Input":1`,
+		`"msg":"Solved","Challenge":"1999-01","Part":"part1","Stats"`,
+	} {
+		if !strings.Contains(lines[i], want) {
+			t.Errorf("line %d = %s, want it to contain %s", i, lines[i], want)
+		}
+	}
+
+	if got := Logger(context.Background()); got != slog.Default() {
+		t.Errorf("Logger() outside the runner = %v, want the default logger", got)
+	}
+}
@@ -0,0 +1,341 @@
+package aoc
+
+import (
+	"encoding"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// ScanError is a mismatch between an input and a Pattern. Line is zero when
+// the input is a single line.
+type ScanError struct {
+	Line, Column int
+	Err          error
+}
+
+func (e *ScanError) Error() string {
+	if e.Line == 0 {
+		return fmt.Sprintf("column %d: %v", e.Column, e.Err)
+	}
+	return fmt.Sprintf("line %d, column %d: %v", e.Line, e.Column, e.Err)
+}
+
+func (e *ScanError) Unwrap() error {
+	return e.Err
+}
+
+// Pattern fills the fields of a struct from text, see Scanf.
+type Pattern[T any] struct {
+	pattern string
+	tokens  []scanToken
+}
+
+// scanToken is either a literal or a field of a pattern.
+type scanToken struct {
+	lit   string
+	field *scanField
+}
+
+type scanField struct {
+	name  string
+	index []int
+	typ   reflect.Type
+	sep   string
+}
+
+var textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+
+// Scanf compiles a pattern for struct T, where {Field} is replaced by the
+// value of a field and {{ and }} are literal braces, for example:
+//
+//	aoc.Scanf[Reindeer]("{Name} can fly {Speed} km/s for {Fly} seconds, but then must rest for {Rest} seconds.")
+//
+// Fields are named by their `scan:"name"` tag or else by their Go name. A
+// field ends where the literal text after it starts, so two fields must be
+// separated by text. White space in the pattern matches any amount of white
+// space in the input.
+//
+// Fields are strings, booleans, numbers, encoding.TextUnmarshalers or slices
+// of those. Slices are split on commas and white space, or on the separator
+// of a `scan:",sep=;"` tag.
+//
+// Scanf panics when the pattern does not fit T.
+func Scanf[T any](pattern string) *Pattern[T] {
+	tokens, err := compileScan(reflect.TypeOf((*T)(nil)).Elem(), pattern)
+	if err != nil {
+		panic(fmt.Sprintf("aoc: Scanf %q: %v", pattern, err))
+	}
+	return &Pattern[T]{pattern: pattern, tokens: tokens}
+}
+
+func (p *Pattern[T]) String() string {
+	return p.pattern
+}
+
+// Parse fills a T from s, which must match the whole pattern.
+func (p *Pattern[T]) Parse(s string) (T, error) {
+	var t T
+	if offset, err := p.scan(s, reflect.ValueOf(&t).Elem()); err != nil {
+		var zero T
+		return zero, scanError(s, offset, err)
+	}
+	return t, nil
+}
+
+// Lines parses a T from every line of r, it is a ParserFunc.
+func (p *Pattern[T]) Lines(r io.Reader) ([]T, error) {
+	return ParseLines(r, p.Parse)
+}
+
+// Input parses a T from all of r, it is a ParserFunc.
+func (p *Pattern[T]) Input(r io.Reader) (T, error) {
+	return ParseInput(r, p.Parse)
+}
+
+func compileScan(t reflect.Type, pattern string) ([]scanToken, error) {
+	if t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("%v is not a struct", t)
+	}
+
+	fields := make(map[string]*scanField)
+	for _, f := range reflect.VisibleFields(t) {
+		if !f.IsExported() || f.Anonymous {
+			continue
+		}
+
+		name, opts, _ := strings.Cut(f.Tag.Get("scan"), ",")
+		switch name {
+		case "-":
+			continue
+		case "":
+			name = f.Name
+		}
+		if _, ok := fields[name]; ok {
+			continue // shadowed by a shallower field
+		}
+
+		field := &scanField{name: name, index: f.Index, typ: f.Type}
+		if sep, ok := strings.CutPrefix(opts, "sep="); ok {
+			field.sep = sep
+		}
+		fields[name] = field
+	}
+
+	var tokens []scanToken
+	var lit strings.Builder
+
+	for i := 0; i < len(pattern); i++ {
+		switch {
+		case strings.HasPrefix(pattern[i:], "{{"), strings.HasPrefix(pattern[i:], "}}"):
+			lit.WriteByte(pattern[i])
+			i++
+		case pattern[i] == '{':
+			end := strings.IndexByte(pattern[i:], '}')
+			if end < 0 {
+				return nil, fmt.Errorf("unclosed { at %d", i+1)
+			}
+			name := pattern[i+1 : i+end]
+			field, ok := fields[name]
+			if !ok {
+				return nil, fmt.Errorf("unknown field %q", name)
+			}
+			if !scannable(field.typ, true) {
+				return nil, fmt.Errorf("field %s: unsupported type %v", name, field.typ)
+			}
+			if lit.Len() > 0 {
+				tokens = append(tokens, scanToken{lit: lit.String()})
+				lit.Reset()
+			} else if len(tokens) > 0 {
+				return nil, fmt.Errorf("no text between fields %s and %s", tokens[len(tokens)-1].field.name, name)
+			}
+			tokens = append(tokens, scanToken{field: field})
+			i += end
+		case pattern[i] == '}':
+			return nil, fmt.Errorf("unopened } at %d", i+1)
+		default:
+			lit.WriteByte(pattern[i])
+		}
+	}
+
+	if lit.Len() > 0 {
+		tokens = append(tokens, scanToken{lit: lit.String()})
+	}
+
+	return tokens, nil
+}
+
+func scannable(t reflect.Type, slice bool) bool {
+	if reflect.PointerTo(t).Implements(textUnmarshalerType) {
+		return true
+	}
+
+	switch t.Kind() {
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	case reflect.Slice:
+		return slice && scannable(t.Elem(), false)
+	}
+	return false
+}
+
+// scan fills v from s, on failure it returns the offset of the mismatch.
+func (p *Pattern[T]) scan(s string, v reflect.Value) (int, error) {
+	var pos int
+
+	for i := 0; i < len(p.tokens); i++ {
+		tok := p.tokens[i]
+
+		if tok.field == nil {
+			end, ok := matchLiteral(tok.lit, s, pos)
+			if !ok {
+				return end, fmt.Errorf("expected %q", tok.lit)
+			}
+			pos = end
+			continue
+		}
+
+		start := pos
+		var value string
+
+		if i+1 == len(p.tokens) {
+			value = strings.TrimRight(s[start:], " \t\r\n")
+			pos = start + len(value)
+		} else {
+			// the field ends where the next literal matches, on a mismatch
+			// the longest partial match is the most likely culprit
+			next := p.tokens[i+1].lit
+			found, best, fail := false, 0, len(s)
+			for j := start + 1; j < len(s); j++ {
+				end, ok := matchLiteral(next, s, j)
+				if ok {
+					value, pos, found = s[start:j], end, true
+					break
+				}
+				if end-j > best {
+					best, fail = end-j, end
+				}
+			}
+			if !found {
+				return fail, fmt.Errorf("expected %q after {%s}", next, tok.field.name)
+			}
+			i++
+		}
+
+		if value == "" {
+			return start, fmt.Errorf("missing {%s}", tok.field.name)
+		}
+		if err := setScan(v.FieldByIndex(tok.field.index), value, tok.field.sep); err != nil {
+			return start, fmt.Errorf("{%s}: %w", tok.field.name, err)
+		}
+	}
+
+	for pos < len(s) && isSpace(s[pos]) {
+		pos++
+	}
+	if pos < len(s) {
+		return pos, fmt.Errorf("unexpected %q", s[pos:])
+	}
+
+	return 0, nil
+}
+
+// matchLiteral matches lit in s at i and returns the end of the match, or
+// the offset of the mismatch.
+func matchLiteral(lit, s string, i int) (int, bool) {
+	for k := 0; k < len(lit); {
+		if isSpace(lit[k]) {
+			if i >= len(s) || !isSpace(s[i]) {
+				return i, false
+			}
+			for k < len(lit) && isSpace(lit[k]) {
+				k++
+			}
+			for i < len(s) && isSpace(s[i]) {
+				i++
+			}
+			continue
+		}
+		if i >= len(s) || s[i] != lit[k] {
+			return i, false
+		}
+		i++
+		k++
+	}
+	return i, true
+}
+
+func isSpace(b byte) bool {
+	return b == ' ' || b == '\t' || b == '\r' || b == '\n'
+}
+
+func setScan(v reflect.Value, s, sep string) error {
+	if u, ok := v.Addr().Interface().(encoding.TextUnmarshaler); ok {
+		return u.UnmarshalText([]byte(s))
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(s)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetFloat(f)
+	case reflect.Slice:
+		var parts []string
+		if sep == "" {
+			parts = strings.FieldsFunc(s, func(r rune) bool {
+				return r == ',' || r < 0x80 && isSpace(byte(r))
+			})
+		} else {
+			parts = strings.Split(s, sep)
+		}
+
+		items := reflect.MakeSlice(v.Type(), len(parts), len(parts))
+		for i, part := range parts {
+			if err := setScan(items.Index(i), strings.TrimSpace(part), ""); err != nil {
+				return fmt.Errorf("item %d: %w", i+1, err)
+			}
+		}
+		v.Set(items)
+	}
+	return nil
+}
+
+// scanError locates the offset in s of a mismatch.
+func scanError(s string, offset int, err error) *ScanError {
+	before := s[:offset]
+	if !strings.Contains(s, "\n") {
+		return &ScanError{Column: offset + 1, Err: err}
+	}
+	return &ScanError{
+		Line:   strings.Count(before, "\n") + 1,
+		Column: offset - strings.LastIndexByte(before, '\n'),
+		Err:    err,
+	}
+}
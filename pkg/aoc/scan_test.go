@@ -0,0 +1,164 @@
+package aoc
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type reindeer struct {
+	Name             string
+	Speed, Fly, Rest int
+	Unused           float64
+	Herd             map[string]int
+	ignored          bool
+}
+
+type color struct {
+	R, G, B uint8
+}
+
+func (c *color) UnmarshalText(b []byte) error {
+	p, err := Scanf[color]("#{R}/{G}/{B}").Parse(string(b))
+	*c = p
+	return err
+}
+
+type node struct {
+	X, Y int
+	Size int      `scan:"size"`
+	Tags []string `scan:",sep=|"`
+	Nums []int
+	Fill color
+}
+
+func TestPattern_Parse(t *testing.T) {
+	deer := Scanf[reindeer]("{Name} can fly {Speed} km/s for {Fly} seconds, but then must rest for {Rest} seconds.")
+	nodes := Scanf[node]("x{X}-y{Y} {size}T [{Tags}] {{{Nums}}} {Fill}")
+
+	tests := []struct {
+		name    string
+		parse   func(string) (any, error)
+		s       string
+		want    any
+		wantErr string
+	}{
+		{
+			name:  "reindeer",
+			parse: func(s string) (any, error) { return deer.Parse(s) },
+			s:     "Comet can fly 14 km/s for 10 seconds, but then must rest for 127 seconds.",
+			want:  reindeer{Name: "Comet", Speed: 14, Fly: 10, Rest: 127},
+		},
+		{
+			name:  "white space",
+			parse: func(s string) (any, error) { return deer.Parse(s) },
+			s:     "Dasher the Great  can fly\t14 km/s for 10 seconds, but then must rest for 127 seconds.\r",
+			want:  reindeer{Name: "Dasher the Great", Speed: 14, Fly: 10, Rest: 127},
+		},
+		{
+			name:    "mismatch",
+			parse:   func(s string) (any, error) { return deer.Parse(s) },
+			s:       "Comet can swim 14 km/s for 10 seconds, but then must rest for 127 seconds.",
+			wantErr: `column 11: expected " can fly " after {Name}`,
+		},
+		{
+			name:    "conversion",
+			parse:   func(s string) (any, error) { return deer.Parse(s) },
+			s:       "Comet can fly fast km/s for 10 seconds, but then must rest for 127 seconds.",
+			wantErr: `column 15: {Speed}: strconv.ParseInt: parsing "fast": invalid syntax`,
+		},
+		{
+			name:    "trailing",
+			parse:   func(s string) (any, error) { return deer.Parse(s) },
+			s:       "Comet can fly 14 km/s for 10 seconds, but then must rest for 127 seconds. Yay",
+			wantErr: `column 75: unexpected "Yay"`,
+		},
+		{
+			name:    "truncated",
+			parse:   func(s string) (any, error) { return deer.Parse(s) },
+			s:       "Comet can fly 14 km/s",
+			wantErr: `column 22: expected " km/s for " after {Speed}`,
+		},
+		{
+			name:  "types",
+			parse: func(s string) (any, error) { return nodes.Parse(s) },
+			s:     "x1-y-2   85T [a|b c] {1, 2 3} #255/0/7",
+			want: node{
+				X: 1, Y: -2, Size: 85,
+				Tags: []string{"a", "b c"},
+				Nums: []int{1, 2, 3},
+				Fill: color{R: 255, B: 7},
+			},
+		},
+		{
+			name:    "unmarshaler",
+			parse:   func(s string) (any, error) { return nodes.Parse(s) },
+			s:       "x1-y2 85T [a] {1} #255/0/700",
+			wantErr: `column 19: {Fill}: column 8: {B}: strconv.ParseUint: parsing "700": value out of range`,
+		},
+		{
+			name:    "multi line",
+			parse:   func(s string) (any, error) { return nodes.Parse(s) },
+			s:       "x1-y2\n85T\n[a] {1, x}\n#1/2/3",
+			wantErr: `line 3, column 6: {Nums}: item 2: strconv.ParseInt: parsing "x": invalid syntax`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.parse(tt.s)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("Parse() error = %v, want %s", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Parse() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPattern_Lines(t *testing.T) {
+	p := Scanf[reindeer]("{Name}: {Speed}")
+
+	got, err := p.Lines(strings.NewReader("a: 1\nb: 2\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []reindeer{{Name: "a", Speed: 1}, {Name: "b", Speed: 2}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Lines() = %v, want %v", got, want)
+	}
+
+	_, err = p.Lines(strings.NewReader("a: 1\nb 2\n"))
+	if want := `line 2, column 4: expected ": " after {Name}`; err == nil || err.Error() != want {
+		t.Errorf("Lines() error = %v, want %s", err, want)
+	}
+}
+
+func TestScanf(t *testing.T) {
+	tests := []struct {
+		name    string
+		pattern string
+	}{
+		{name: "unknown field", pattern: "{Name} {Age}"},
+		{name: "unexported field", pattern: "{ignored}"},
+		{name: "unsupported field", pattern: "{Herd}"},
+		{name: "adjacent fields", pattern: "{Name}{Speed}"},
+		{name: "unclosed", pattern: "{Name"},
+		{name: "unopened", pattern: "Name}"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Scanf(%q) did not panic", tt.pattern)
+				}
+			}()
+			Scanf[reindeer](tt.pattern)
+		})
+	}
+}
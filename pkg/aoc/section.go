@@ -0,0 +1,199 @@
+package aoc
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/pimvanhespen/advent-of-code/pkg/grid"
+)
+
+var ErrIgnoreSection = errors.New("ignore section")
+
+// Section is a run of lines of an input. Sections of a puzzle are separated
+// by blank lines, like the seeds and maps of 2023/05.
+type Section struct {
+	Line  int // number of the first line in the input
+	Lines []string
+}
+
+// ReadSection reads all of r as one section, without trailing blank lines.
+func ReadSection(r io.Reader) (Section, error) {
+	lines, err := ReadLines(r)
+	if err != nil {
+		return Section{}, err
+	}
+	for len(lines) > 0 && strings.TrimSpace(lines[len(lines)-1]) == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return Section{Line: 1, Lines: lines}, nil
+}
+
+func (s Section) String() string {
+	return strings.Join(s.Lines, "\n")
+}
+
+// Blocks splits the section on blank lines, leaving out the blank lines.
+func (s Section) Blocks() []Section {
+	var blocks []Section
+	start := -1
+	for i := 0; i <= len(s.Lines); i++ {
+		if i < len(s.Lines) && strings.TrimSpace(s.Lines[i]) != "" {
+			if start < 0 {
+				start = i
+			}
+			continue
+		}
+		if start >= 0 {
+			blocks = append(blocks, Section{Line: s.Line + start, Lines: s.Lines[start:i]})
+			start = -1
+		}
+	}
+	return blocks
+}
+
+// Cut splits the section before its line n, to parse a title apart from the
+// lines below it.
+func (s Section) Cut(n int) (Section, Section) {
+	n = min(n, len(s.Lines))
+	return Section{Line: s.Line, Lines: s.Lines[:n]}, Section{Line: s.Line + n, Lines: s.Lines[n:]}
+}
+
+// lineError adds the number of line i of the section to err.
+func (s Section) lineError(i int, err error) error {
+	if se, ok := err.(*ScanError); ok && se.Line == 0 {
+		return &ScanError{Line: s.Line + i, Column: se.Column, Err: se.Err}
+	}
+	return fmt.Errorf("line %d: %w", s.Line+i, err)
+}
+
+// SectionParser parses a section.
+type SectionParser[T any] func(Section) (T, error)
+
+// ParseSections parses every block of r with fn, see Each.
+func ParseSections[T any](r io.Reader, fn SectionParser[T]) ([]T, error) {
+	s, err := ReadSection(r)
+	if err != nil {
+		return nil, err
+	}
+	return Each(fn)(s)
+}
+
+// Pair is the result of Sections.
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
+// Sections returns a parser of inputs in two parts: the first block is parsed
+// by first and everything after it by rest, for example
+//
+//	aoc.Sections(aoc.Line(aoc.Ints), aoc.Each(parseMap))
+//
+// parses a line of seeds followed by any number of maps.
+func Sections[A, B any](first SectionParser[A], rest SectionParser[B]) ParserFunc[Pair[A, B]] {
+	return func(r io.Reader) (Pair[A, B], error) {
+		s, err := ReadSection(r)
+		if err != nil {
+			return Pair[A, B]{}, err
+		}
+
+		blocks := s.Blocks()
+		if len(blocks) == 0 {
+			return Pair[A, B]{}, errors.New("empty input")
+		}
+		head := blocks[0]
+
+		var p Pair[A, B]
+		if p.First, err = first(head); err != nil {
+			return Pair[A, B]{}, err
+		}
+
+		// the rest starts at the second block and is empty without one
+		tail := Section{Line: head.Line + len(head.Lines)}
+		if len(blocks) > 1 {
+			tail = Section{Line: blocks[1].Line, Lines: s.Lines[blocks[1].Line-s.Line:]}
+		}
+		if p.Second, err = rest(tail); err != nil {
+			return Pair[A, B]{}, err
+		}
+
+		return p, nil
+	}
+}
+
+// Each parses every block of a section with fn. Blocks for which fn returns
+// ErrIgnoreSection are skipped.
+func Each[T any](fn SectionParser[T]) SectionParser[[]T] {
+	return func(s Section) ([]T, error) {
+		var result []T
+		for _, b := range s.Blocks() {
+			v, err := fn(b)
+			if err != nil {
+				if errors.Is(err, ErrIgnoreSection) {
+					continue
+				}
+				return nil, err
+			}
+			result = append(result, v)
+		}
+		return result, nil
+	}
+}
+
+// Lines parses every line of a section with fn, like ParseLines. Errors of
+// fn get the number of their line.
+func Lines[T any](fn func(string) (T, error)) SectionParser[[]T] {
+	return func(s Section) ([]T, error) {
+		var result []T
+		for i, line := range s.Lines {
+			v, err := fn(line)
+			if err != nil {
+				if errors.Is(err, IgnoreLine) {
+					continue
+				}
+				return nil, s.lineError(i, err)
+			}
+			result = append(result, v)
+		}
+		return result, nil
+	}
+}
+
+// Line parses a section of a single line with fn.
+func Line[T any](fn func(string) (T, error)) SectionParser[T] {
+	return func(s Section) (T, error) {
+		var zero T
+		if len(s.Lines) != 1 {
+			return zero, fmt.Errorf("line %d: section of %d lines, want 1", s.Line, len(s.Lines))
+		}
+		v, err := fn(s.Lines[0])
+		if err != nil {
+			return zero, s.lineError(0, err)
+		}
+		return v, nil
+	}
+}
+
+// GridSection parses a section as a grid with a cell per byte, see grid.Parse.
+func GridSection[T any](cell func(b byte) (T, error)) SectionParser[grid.Grid[T]] {
+	return func(s Section) (grid.Grid[T], error) {
+		rows := make([][]T, len(s.Lines))
+		for y, line := range s.Lines {
+			if len(line) != len(s.Lines[0]) {
+				return grid.Grid[T]{}, fmt.Errorf("line %d: %d cells, want %d", s.Line+y, len(line), len(s.Lines[0]))
+			}
+
+			rows[y] = make([]T, len(line))
+			for x := 0; x < len(line); x++ {
+				v, err := cell(line[x])
+				if err != nil {
+					return grid.Grid[T]{}, &ScanError{Line: s.Line + y, Column: x + 1, Err: err}
+				}
+				rows[y][x] = v
+			}
+		}
+		return grid.FromRows(rows)
+	}
+}
@@ -0,0 +1,158 @@
+package aoc
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/pimvanhespen/advent-of-code/pkg/grid"
+)
+
+func TestSection_Blocks(t *testing.T) {
+	s := Section{Line: 3, Lines: []string{"", "a", "b", "", " ", "c", ""}}
+
+	want := []Section{
+		{Line: 4, Lines: []string{"a", "b"}},
+		{Line: 8, Lines: []string{"c"}},
+	}
+	if got := s.Blocks(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Blocks() = %v, want %v", got, want)
+	}
+}
+
+func TestSections(t *testing.T) {
+	type span struct {
+		Name     string
+		From, To int
+	}
+	spans := Lines(Scanf[span]("{Name}: {From}-{To}").Parse)
+	parse := Sections(Line(Ints), Each(spans))
+
+	tests := []struct {
+		name    string
+		input   string
+		want    Pair[[]int, [][]span]
+		wantErr string
+	}{
+		{
+			name:  "example",
+			input: "1 2 3\n\na: 1-2\nb: 3-4\n\nc: 5-6\n",
+			want: Pair[[]int, [][]span]{
+				First: []int{1, 2, 3},
+				Second: [][]span{
+					{{Name: "a", From: 1, To: 2}, {Name: "b", From: 3, To: 4}},
+					{{Name: "c", From: 5, To: 6}},
+				},
+			},
+		},
+		{
+			name:  "no rest",
+			input: "1\n\n\n",
+			want:  Pair[[]int, [][]span]{First: []int{1}},
+		},
+		{
+			name:    "line number",
+			input:   "1\n\na: 1-2\n\n\nb: 3-x\n",
+			wantErr: `line 6, column 6: {To}: strconv.ParseInt: parsing "x": invalid syntax`,
+		},
+		{
+			name:    "long first section",
+			input:   "1\n2\n\na: 1-2\n",
+			wantErr: "line 1: section of 2 lines, want 1",
+		},
+		{
+			name:    "empty",
+			input:   "\n",
+			wantErr: "empty input",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parse(strings.NewReader(tt.input))
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("parse() error = %v, want %s", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parse() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parse() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseSections(t *testing.T) {
+	const input = "#.\n.#\n\n# comment\n\n..\n##\n"
+
+	got, err := ParseSections(strings.NewReader(input), func(s Section) (grid.Grid[bool], error) {
+		if strings.HasPrefix(s.Lines[0], "# ") {
+			return grid.Grid[bool]{}, ErrIgnoreSection
+		}
+		return GridSection(func(b byte) (bool, error) { return b == '#', nil })(s)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{"#.\n.#\n", "..\n##\n"}
+	if len(got) != len(want) {
+		t.Fatalf("ParseSections() returned %d grids, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if got[i].String() != want[i] {
+			t.Errorf("grid %d = %q, want %q", i, got[i].String(), want[i])
+		}
+	}
+}
+
+func TestLines(t *testing.T) {
+	atoi := Lines(func(line string) (int, error) {
+		if line == "-" {
+			return 0, IgnoreLine
+		}
+		return strconv.Atoi(line)
+	})
+
+	got, err := atoi(Section{Line: 10, Lines: []string{"1", "-", "2"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []int{1, 2}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Lines() = %v, want %v", got, want)
+	}
+
+	_, err = atoi(Section{Line: 10, Lines: []string{"1", "-", "x"}})
+	if !errors.Is(err, strconv.ErrSyntax) || !strings.HasPrefix(err.Error(), "line 12: ") {
+		t.Errorf("Lines() error = %v, want a syntax error on line 12", err)
+	}
+}
+
+func TestGridSection(t *testing.T) {
+	digit := GridSection(func(b byte) (int, error) {
+		return strconv.Atoi(string(b))
+	})
+
+	g, err := digit(Section{Line: 5, Lines: []string{"12", "34"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := g.At(grid.Point{X: 1, Y: 1}); got != 4 {
+		t.Errorf("At(1, 1) = %d, want 4", got)
+	}
+
+	_, err = digit(Section{Line: 5, Lines: []string{"12", "3x"}})
+	var se *ScanError
+	if !errors.As(err, &se) || se.Line != 6 || se.Column != 2 {
+		t.Errorf("GridSection() error = %v, want one at line 6, column 2", err)
+	}
+
+	if _, err = digit(Section{Line: 5, Lines: []string{"12", "3"}}); err == nil {
+		t.Error("GridSection() of ragged rows did not fail")
+	}
+}
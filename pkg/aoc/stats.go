@@ -0,0 +1,119 @@
+package aoc
+
+import (
+	"fmt"
+	"log/slog"
+	"math"
+	"runtime"
+	"sort"
+	"time"
+)
+
+// Measurement is the cost of a single run of a function.
+type Measurement struct {
+	Duration time.Duration
+	Allocs   uint64
+	Bytes    uint64
+}
+
+// measure runs fn and records its duration and heap allocations.
+func measure(fn func()) Measurement {
+	var before, after runtime.MemStats
+
+	runtime.ReadMemStats(&before)
+	start := time.Now()
+
+	fn()
+
+	d := time.Since(start)
+	runtime.ReadMemStats(&after)
+
+	return Measurement{
+		Duration: d,
+		Allocs:   after.Mallocs - before.Mallocs,
+		Bytes:    after.TotalAlloc - before.TotalAlloc,
+	}
+}
+
+// Stats summarizes the measurements of one or more runs.
+// Allocs and Bytes are averages per run.
+type Stats struct {
+	Runs   int           `json:"runs"`
+	Min    time.Duration `json:"min_ns"`
+	Median time.Duration `json:"median_ns"`
+	P95    time.Duration `json:"p95_ns"`
+	Allocs uint64        `json:"allocs"`
+	Bytes  uint64        `json:"bytes"`
+}
+
+func summarize(ms []Measurement) Stats {
+	if len(ms) == 0 {
+		return Stats{}
+	}
+
+	durations := make([]time.Duration, len(ms))
+	var allocs, bytes uint64
+	for i, m := range ms {
+		durations[i] = m.Duration
+		allocs += m.Allocs
+		bytes += m.Bytes
+	}
+
+	sort.Slice(durations, func(i, j int) bool { return durations[i] < durations[j] })
+
+	n := len(durations)
+	median := durations[n/2]
+	if n%2 == 0 {
+		median = (durations[n/2-1] + durations[n/2]) / 2
+	}
+
+	return Stats{
+		Runs:   n,
+		Min:    durations[0],
+		Median: median,
+		P95:    durations[int(math.Ceil(0.95*float64(n)))-1],
+		Allocs: allocs / uint64(n),
+		Bytes:  bytes / uint64(n),
+	}
+}
+
+func (s Stats) String() string {
+	if s.Runs > 1 {
+		return fmt.Sprintf("min %s median %s p95 %s, %d allocs %s", s.Min, s.Median, s.P95, s.Allocs, FormatBytes(s.Bytes))
+	}
+	return fmt.Sprintf("%s, %d allocs %s", s.Min, s.Allocs, FormatBytes(s.Bytes))
+}
+
+func (s Stats) LogValue() slog.Value {
+	attrs := []slog.Attr{
+		slog.Duration("Duration", s.Median),
+		slog.Uint64("Allocs", s.Allocs),
+		slog.String("Bytes", FormatBytes(s.Bytes)),
+	}
+
+	if s.Runs > 1 {
+		attrs = append(attrs,
+			slog.Int("Runs", s.Runs),
+			slog.Duration("Min", s.Min),
+			slog.Duration("P95", s.P95),
+		)
+	}
+
+	return slog.GroupValue(attrs...)
+}
+
+// FormatBytes formats a number of bytes using binary prefixes.
+func FormatBytes(b uint64) string {
+	const unit = 1024
+	if b < unit {
+		return fmt.Sprintf("%dB", b)
+	}
+
+	div, exp := uint64(unit), 0
+	for n := b / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+
+	return fmt.Sprintf("%.1f%ciB", float64(b)/float64(div), "KMGTPE"[exp])
+}
@@ -0,0 +1,59 @@
+package aoc
+
+import (
+	"testing"
+	"time"
+)
+
+func Test_summarize(t *testing.T) {
+	tests := []struct {
+		name string
+		ms   []Measurement
+		want Stats
+	}{
+		{
+			name: "empty",
+			want: Stats{},
+		},
+		{
+			name: "single",
+			ms:   []Measurement{{Duration: time.Second, Allocs: 3, Bytes: 100}},
+			want: Stats{Runs: 1, Min: time.Second, Median: time.Second, P95: time.Second, Allocs: 3, Bytes: 100},
+		},
+		{
+			name: "even",
+			ms: []Measurement{
+				{Duration: 4 * time.Millisecond, Allocs: 2, Bytes: 10},
+				{Duration: 1 * time.Millisecond, Allocs: 4, Bytes: 30},
+				{Duration: 3 * time.Millisecond, Allocs: 2, Bytes: 10},
+				{Duration: 2 * time.Millisecond, Allocs: 4, Bytes: 30},
+			},
+			want: Stats{Runs: 4, Min: time.Millisecond, Median: 2500 * time.Microsecond, P95: 4 * time.Millisecond, Allocs: 3, Bytes: 20},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := summarize(tt.ms); got != tt.want {
+				t.Errorf("summarize() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatBytes(t *testing.T) {
+	tests := []struct {
+		b    uint64
+		want string
+	}{
+		{0, "0B"},
+		{1023, "1023B"},
+		{1024, "1.0KiB"},
+		{1536, "1.5KiB"},
+		{5 << 20, "5.0MiB"},
+	}
+	for _, tt := range tests {
+		if got := FormatBytes(tt.b); got != tt.want {
+			t.Errorf("FormatBytes(%d) = %q, want %q", tt.b, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,142 @@
+package aoc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"html"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SubmitStatus is the verdict of the site on a submitted answer.
+type SubmitStatus string
+
+const (
+	Correct       SubmitStatus = "correct"
+	Incorrect     SubmitStatus = "incorrect"
+	TooHigh       SubmitStatus = "too high"
+	TooLow        SubmitStatus = "too low"
+	RateLimited   SubmitStatus = "rate limited"
+	AlreadySolved SubmitStatus = "already solved"
+)
+
+// Rejected reports whether the status marks the answer as wrong.
+func (s SubmitStatus) Rejected() bool {
+	return s == Incorrect || s == TooHigh || s == TooLow
+}
+
+// SubmitResult is the parsed response to a submitted answer.
+type SubmitResult struct {
+	Status SubmitStatus
+	// Wait is the time to wait before the next answer may be submitted.
+	Wait time.Duration
+	// Message is the plain text message shown by the site.
+	Message string
+}
+
+func (r SubmitResult) String() string {
+	if r.Wait > 0 {
+		return fmt.Sprintf("%s (wait %s)", r.Status, r.Wait)
+	}
+	return string(r.Status)
+}
+
+var ErrUnknownResponse = errors.New("unrecognized response")
+
+var (
+	articleReg = regexp.MustCompile(`(?s)<article[^>]*>(.*?)</article>`)
+	tagReg     = regexp.MustCompile(`<[^>]*>`)
+	leftReg    = regexp.MustCompile(`you have (?:(\d+)m )?(\d+)s left to wait`)
+	waitReg    = regexp.MustCompile(`please wait (one|\d+) minutes?`)
+)
+
+// parseSubmitResponse extracts the verdict from the HTML page that is returned
+// after submitting an answer.
+func parseSubmitResponse(page string) (SubmitResult, error) {
+	match := articleReg.FindStringSubmatch(page)
+	if match == nil {
+		return SubmitResult{}, fmt.Errorf("%w: no article", ErrUnknownResponse)
+	}
+
+	msg := html.UnescapeString(tagReg.ReplaceAllString(match[1], ""))
+	msg = strings.Join(strings.Fields(msg), " ")
+
+	result := SubmitResult{Message: msg}
+	lower := strings.ToLower(msg)
+
+	switch {
+	case strings.Contains(lower, "that's the right answer"):
+		result.Status = Correct
+	case strings.Contains(lower, "answer is too high"):
+		result.Status = TooHigh
+	case strings.Contains(lower, "answer is too low"):
+		result.Status = TooLow
+	case strings.Contains(lower, "not the right answer"):
+		result.Status = Incorrect
+	case strings.Contains(lower, "gave an answer too recently"):
+		result.Status = RateLimited
+	case strings.Contains(lower, "don't seem to be solving the right level"):
+		result.Status = AlreadySolved
+	default:
+		return SubmitResult{}, fmt.Errorf("%w: %q", ErrUnknownResponse, msg)
+	}
+
+	if m := leftReg.FindStringSubmatch(lower); m != nil {
+		minutes, _ := strconv.Atoi(m[1])
+		seconds, _ := strconv.Atoi(m[2])
+		result.Wait = time.Duration(minutes)*time.Minute + time.Duration(seconds)*time.Second
+	} else if m = waitReg.FindStringSubmatch(lower); m != nil {
+		minutes, err := strconv.Atoi(m[1])
+		if err != nil {
+			minutes = 1
+		}
+		result.Wait = time.Duration(minutes) * time.Minute
+	}
+
+	return result, nil
+}
+
+var ErrSolved = errors.New("part already solved")
+
+// ErrRejected is returned when an answer is known to be wrong.
+type ErrRejected struct {
+	Rejection Rejection
+}
+
+func (e ErrRejected) Error() string {
+	return fmt.Sprintf("answer was rejected before: %s is %s", e.Rejection.Answer, e.Rejection.Status)
+}
+
+// Submit submits the answer of the given part unless it is known to be right
+// or wrong. The verdict of the site is recorded in the answers of the challenge.
+func (p Challenge) Submit(ctx context.Context, c *Client, part int, answer string) (SubmitResult, error) {
+	answers, err := p.Answers()
+	if err != nil {
+		return SubmitResult{}, err
+	}
+
+	if _, ok := answers.Get(part); ok {
+		return SubmitResult{}, fmt.Errorf("%s part %d: %w", p, part, ErrSolved)
+	}
+
+	if r, ok := answers.Rejected(part, answer); ok {
+		return SubmitResult{}, fmt.Errorf("%s part %d: %w", p, part, ErrRejected{Rejection: r})
+	}
+
+	result, err := c.SubmitAnswer(ctx, p.Year, p.Day, part, answer)
+	if err != nil {
+		return SubmitResult{}, err
+	}
+
+	switch {
+	case result.Status == Correct:
+		err = p.StoreAnswer(part, answer)
+	case result.Status.Rejected():
+		err = p.StoreRejection(part, Rejection{Answer: answer, Status: result.Status})
+	}
+
+	return result, err
+}
@@ -0,0 +1,355 @@
+package aoc
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+)
+
+// NewTransport returns the transport used for all traffic to the site.
+// It follows the automation guidelines of the site: requests are throttled
+// across processes, failed GET requests are retried with backoff and
+// responses with an ETag or Last-Modified header are cached on disk.
+func NewTransport(opts ...TransportOption) http.RoundTripper {
+	o := transportDefaults()
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	var rt http.RoundTripper = o.base
+
+	if o.interval > 0 {
+		rt = &throttle{next: rt, interval: o.interval, state: o.stateFile}
+	}
+
+	if o.retries > 0 {
+		rt = &retry{next: rt, retries: o.retries, backoff: o.backoff}
+	}
+
+	if o.cacheDir != "" {
+		rt = &cache{next: rt, dir: o.cacheDir}
+	}
+
+	return rt
+}
+
+type TransportOption func(*transportOptions)
+
+// WithBaseTransport sets the transport that performs the actual requests.
+func WithBaseTransport(rt http.RoundTripper) TransportOption {
+	return func(o *transportOptions) {
+		o.base = rt
+	}
+}
+
+// WithInterval sets the minimum time between two requests, zero disables throttling.
+func WithInterval(d time.Duration) TransportOption {
+	return func(o *transportOptions) {
+		o.interval = d
+	}
+}
+
+// WithStateFile sets the file that records the time of the last request,
+// shared by all processes using the same file.
+func WithStateFile(path string) TransportOption {
+	return func(o *transportOptions) {
+		o.stateFile = path
+	}
+}
+
+// WithRetries sets how often a failed GET request is retried and the initial backoff.
+func WithRetries(n int, backoff time.Duration) TransportOption {
+	return func(o *transportOptions) {
+		o.retries = n
+		o.backoff = backoff
+	}
+}
+
+// WithCacheDir sets the directory to cache responses in, empty disables caching.
+func WithCacheDir(dir string) TransportOption {
+	return func(o *transportOptions) {
+		o.cacheDir = dir
+	}
+}
+
+type transportOptions struct {
+	base      http.RoundTripper
+	interval  time.Duration
+	stateFile string
+	retries   int
+	backoff   time.Duration
+	cacheDir  string
+}
+
+func transportDefaults() *transportOptions {
+	o := &transportOptions{
+		base:     http.DefaultTransport,
+		interval: 5 * time.Second,
+		retries:  3,
+		backoff:  time.Second,
+	}
+
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		dir = os.TempDir()
+	}
+
+	o.stateFile = filepath.Join(dir, "aoc", "throttle")
+	o.cacheDir = filepath.Join(dir, "aoc", "http")
+
+	return o
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+// throttle delays requests so at most one request is made per interval.
+type throttle struct {
+	next     http.RoundTripper
+	interval time.Duration
+	state    string
+}
+
+func (t *throttle) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.wait(req.Context()); err != nil {
+		return nil, fmt.Errorf("throttle: %w", err)
+	}
+	return t.next.RoundTrip(req)
+}
+
+// wait blocks until the interval since the last request has passed.
+// The lock is held while waiting so other processes queue up behind it.
+func (t *throttle) wait(ctx context.Context) error {
+	if err := os.MkdirAll(filepath.Dir(t.state), 0755); err != nil {
+		return err
+	}
+
+	unlock, err := lock(ctx, t.state+".lock", t.interval+time.Minute)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	var last time.Time
+	if b, err := os.ReadFile(t.state); err == nil {
+		if ns, err := strconv.ParseInt(string(bytes.TrimSpace(b)), 10, 64); err == nil {
+			last = time.Unix(0, ns)
+		}
+	}
+
+	if d := time.Until(last.Add(t.interval)); d > 0 {
+		if err = sleep(ctx, d); err != nil {
+			return err
+		}
+	}
+
+	return os.WriteFile(t.state, []byte(strconv.FormatInt(time.Now().UnixNano(), 10)), 0644)
+}
+
+// lock acquires a lock file. Locks older than stale are considered abandoned.
+func lock(ctx context.Context, path string, stale time.Duration) (func(), error) {
+	for {
+		f, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0644)
+		if err == nil {
+			_ = f.Close()
+			return func() { _ = os.Remove(path) }, nil
+		}
+
+		if !errors.Is(err, fs.ErrExist) {
+			return nil, err
+		}
+
+		if info, err := os.Stat(path); err == nil && time.Since(info.ModTime()) > stale {
+			_ = os.Remove(path)
+			continue
+		}
+
+		if err = sleep(ctx, 50*time.Millisecond); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// retry retries idempotent requests that failed or got a server error.
+type retry struct {
+	next    http.RoundTripper
+	retries int
+	backoff time.Duration
+}
+
+func (r *retry) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet && req.Method != http.MethodHead {
+		return r.next.RoundTrip(req)
+	}
+
+	for attempt := 0; ; attempt++ {
+		resp, err := r.next.RoundTrip(req)
+
+		switch {
+		case err != nil && req.Context().Err() != nil:
+			return nil, err
+		case err == nil && resp.StatusCode < 500 && resp.StatusCode != http.StatusTooManyRequests:
+			return resp, nil
+		case attempt == r.retries:
+			return resp, err
+		}
+
+		delay := r.backoff << attempt
+		if resp != nil {
+			if d, ok := retryAfter(resp.Header.Get("Retry-After")); ok {
+				delay = d
+			}
+			_, _ = io.Copy(io.Discard, resp.Body)
+			_ = resp.Body.Close()
+		}
+
+		if err = sleep(req.Context(), delay); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// retryAfter parses the value of a Retry-After header, either seconds or a date.
+func retryAfter(v string) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+
+	if s, err := strconv.Atoi(v); err == nil {
+		return time.Duration(s) * time.Second, true
+	}
+
+	if t, err := http.ParseTime(v); err == nil {
+		return max(time.Until(t), 0), true
+	}
+
+	return 0, false
+}
+
+// cache stores GET responses that carry validators and revalidates them
+// with conditional requests.
+type cache struct {
+	next http.RoundTripper
+	dir  string
+}
+
+type cacheEntry struct {
+	Header http.Header `json:"header"`
+	Body   []byte      `json:"body"`
+}
+
+func (c *cache) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet {
+		return c.next.RoundTrip(req)
+	}
+
+	key := c.key(req)
+	entry, cached := c.load(key)
+
+	if cached {
+		req = req.Clone(req.Context())
+		if etag := entry.Header.Get("ETag"); etag != "" {
+			req.Header.Set("If-None-Match", etag)
+		}
+		if lm := entry.Header.Get("Last-Modified"); lm != "" {
+			req.Header.Set("If-Modified-Since", lm)
+		}
+	}
+
+	resp, err := c.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if cached && resp.StatusCode == http.StatusNotModified {
+		_ = resp.Body.Close()
+		return entry.response(req), nil
+	}
+
+	if resp.StatusCode != http.StatusOK || (resp.Header.Get("ETag") == "" && resp.Header.Get("Last-Modified") == "") {
+		return resp, nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	// a failing cache must not fail the request
+	_ = c.store(key, cacheEntry{Header: resp.Header, Body: body})
+
+	return resp, nil
+}
+
+// key identifies a request; the session is part of it since responses are personal.
+func (c *cache) key(req *http.Request) string {
+	h := sha256.New()
+	_, _ = io.WriteString(h, req.URL.String())
+	_, _ = io.WriteString(h, "\n")
+	_, _ = io.WriteString(h, req.Header.Get("Cookie"))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func (c *cache) load(key string) (cacheEntry, bool) {
+	b, err := os.ReadFile(filepath.Join(c.dir, key+".json"))
+	if err != nil {
+		return cacheEntry{}, false
+	}
+
+	var entry cacheEntry
+	if err = json.Unmarshal(b, &entry); err != nil {
+		return cacheEntry{}, false
+	}
+
+	return entry, true
+}
+
+func (c *cache) store(key string, entry cacheEntry) error {
+	if err := os.MkdirAll(c.dir, 0700); err != nil {
+		return err
+	}
+
+	b, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(c.dir, key+".json"), b, 0600)
+}
+
+func (e cacheEntry) response(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        e.Header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(e.Body)),
+		ContentLength: int64(len(e.Body)),
+		Request:       req,
+	}
+}
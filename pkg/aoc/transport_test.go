@@ -0,0 +1,143 @@
+package aoc
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func get(t *testing.T, client *http.Client, url string) (int, string) {
+	t.Helper()
+
+	resp, err := client.Get(url)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	defer resp.Body.Close()
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return resp.StatusCode, string(b)
+}
+
+func TestTransport_Retry(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch calls.Add(1) {
+		case 1:
+			w.WriteHeader(http.StatusBadGateway)
+		case 2:
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusServiceUnavailable)
+		default:
+			_, _ = io.WriteString(w, "ok")
+		}
+	}))
+	defer srv.Close()
+
+	client := &http.Client{Transport: NewTransport(
+		WithInterval(0),
+		WithCacheDir(""),
+		WithRetries(3, time.Millisecond),
+	)}
+
+	status, body := get(t, client, srv.URL)
+	if status != http.StatusOK || body != "ok" {
+		t.Errorf("Get() = %d %q, want 200 \"ok\"", status, body)
+	}
+	if n := calls.Load(); n != 3 {
+		t.Errorf("calls = %d, want 3", n)
+	}
+
+	// requests that are not idempotent are never retried
+	calls.Store(0)
+	resp, err := client.Post(srv.URL, "text/plain", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusBadGateway || calls.Load() != 1 {
+		t.Errorf("Post() = %d after %d calls, want 502 after 1 call", resp.StatusCode, calls.Load())
+	}
+}
+
+func TestTransport_Cache(t *testing.T) {
+	var calls, revalidated atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			revalidated.Add(1)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		_, _ = io.WriteString(w, "puzzle input")
+	}))
+	defer srv.Close()
+
+	client := &http.Client{Transport: NewTransport(
+		WithInterval(0),
+		WithCacheDir(t.TempDir()),
+	)}
+
+	for i := 0; i < 2; i++ {
+		status, body := get(t, client, srv.URL)
+		if status != http.StatusOK || body != "puzzle input" {
+			t.Errorf("Get() #%d = %d %q, want 200 \"puzzle input\"", i, status, body)
+		}
+	}
+
+	if calls.Load() != 2 || revalidated.Load() != 1 {
+		t.Errorf("calls = %d, revalidated = %d, want 2 and 1", calls.Load(), revalidated.Load())
+	}
+}
+
+func TestTransport_Throttle(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer srv.Close()
+
+	const interval = 100 * time.Millisecond
+
+	// two transports sharing a state file behave like two processes
+	state := filepath.Join(t.TempDir(), "throttle")
+	a := &http.Client{Transport: NewTransport(WithInterval(interval), WithStateFile(state), WithCacheDir(""))}
+	b := &http.Client{Transport: NewTransport(WithInterval(interval), WithStateFile(state), WithCacheDir(""))}
+
+	start := time.Now()
+	get(t, a, srv.URL)
+	get(t, b, srv.URL)
+	get(t, a, srv.URL)
+
+	if elapsed := time.Since(start); elapsed < 2*interval {
+		t.Errorf("3 requests took %s, want at least %s", elapsed, 2*interval)
+	}
+}
+
+func Test_retryAfter(t *testing.T) {
+	tests := []struct {
+		name   string
+		value  string
+		want   time.Duration
+		wantOk bool
+	}{
+		{"empty", "", 0, false},
+		{"seconds", "120", 2 * time.Minute, true},
+		{"past date", "Wed, 21 Oct 2015 07:28:00 GMT", 0, true},
+		{"invalid", "soon", 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := retryAfter(tt.value)
+			if got != tt.want || ok != tt.wantOk {
+				t.Errorf("retryAfter() = %v, %v, want %v, %v", got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+}
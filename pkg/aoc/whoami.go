@@ -0,0 +1,106 @@
+package aoc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var ErrLoggedOut = errors.New("session is not logged in, renew the session cookie")
+
+// User is the account a session belongs to.
+type User struct {
+	Name string
+	// Tier is the support badge shown next to the name, such as "AoC++",
+	// or empty when the user does not support the site.
+	Tier  string
+	Stars int
+}
+
+func (u User) String() string {
+	s := u.Name
+	if u.Tier != "" {
+		s += " " + u.Tier
+	}
+	return fmt.Sprintf("%s (%d*)", s, u.Stars)
+}
+
+var (
+	userReg  = regexp.MustCompile(`(?s)<div class="user">(.*?)</div>`)
+	badgeReg = regexp.MustCompile(`(?s)<a[^>]*class="(?:supporter|sponsor)-badge"[^>]*>(.*?)</a>`)
+	starsReg = regexp.MustCompile(`<span class="star-count">(\d+)\*</span>`)
+)
+
+// parseUser extracts the logged-in user from the header of any page of the site.
+func parseUser(page string) (*User, error) {
+	m := userReg.FindStringSubmatch(page)
+	if m == nil {
+		return nil, ErrLoggedOut
+	}
+
+	var u User
+
+	div := m[1]
+	if b := badgeReg.FindStringSubmatch(div); b != nil {
+		u.Tier = strings.Trim(html.UnescapeString(b[1]), "()")
+		div = strings.Replace(div, b[0], "", 1)
+	}
+
+	if s := starsReg.FindStringSubmatch(div); s != nil {
+		u.Stars, _ = strconv.Atoi(s[1])
+		div = strings.Replace(div, s[0], "", 1)
+	}
+
+	u.Name = strings.TrimSpace(html.UnescapeString(tagReg.ReplaceAllString(div, "")))
+
+	return &u, nil
+}
+
+// Whoami returns the user the session of the client belongs to.
+func (c *Client) Whoami(ctx context.Context) (*User, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.base.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("status code %d", resp.StatusCode)
+	}
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseUser(string(b))
+}
+
+// validate checks once per client that the session is logged in.
+func (c *Client) validate(ctx context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.user != nil {
+		return nil
+	}
+
+	u, err := c.Whoami(ctx)
+	if err != nil {
+		return fmt.Errorf("validate session: %w", err)
+	}
+
+	c.user = u
+	return nil
+}
@@ -0,0 +1,81 @@
+package geom
+
+import "fmt"
+
+// Dir is one of the 8 compass directions, clockwise from North.
+type Dir int
+
+const (
+	North Dir = iota
+	NorthEast
+	East
+	SouthEast
+	South
+	SouthWest
+	West
+	NorthWest
+)
+
+// Cardinals are the 4 orthogonal directions, clockwise from North.
+var Cardinals = []Dir{North, East, South, West}
+
+// Dirs are all 8 directions, clockwise from North.
+var Dirs = []Dir{North, NorthEast, East, SouthEast, South, SouthWest, West, NorthWest}
+
+var dirVecs = [...]Vec2[int]{
+	North:     {0, -1},
+	NorthEast: {1, -1},
+	East:      {1, 0},
+	SouthEast: {1, 1},
+	South:     {0, 1},
+	SouthWest: {-1, 1},
+	West:      {-1, 0},
+	NorthWest: {-1, -1},
+}
+
+var dirNames = [...]string{"N", "NE", "E", "SE", "S", "SW", "W", "NW"}
+
+// Vec returns the unit step in direction d, with Y growing downwards.
+func (d Dir) Vec() Vec2[int] {
+	return dirVecs[d.norm()]
+}
+
+func (d Dir) norm() Dir {
+	return (d%8 + 8) % 8
+}
+
+// TurnRight returns the direction a quarter turn clockwise.
+func (d Dir) TurnRight() Dir {
+	return (d + 2).norm()
+}
+
+// TurnLeft returns the direction a quarter turn counterclockwise.
+func (d Dir) TurnLeft() Dir {
+	return (d - 2).norm()
+}
+
+// Reverse returns the opposite direction.
+func (d Dir) Reverse() Dir {
+	return (d + 4).norm()
+}
+
+func (d Dir) String() string {
+	return dirNames[d.norm()]
+}
+
+// ParseDir parses a direction as the puzzles write them: U, D, L and R,
+// ^, v, < and >, or N, E, S and W.
+func ParseDir(s string) (Dir, error) {
+	switch s {
+	case "U", "^", "N":
+		return North, nil
+	case "R", ">", "E":
+		return East, nil
+	case "D", "v", "S":
+		return South, nil
+	case "L", "<", "W":
+		return West, nil
+	default:
+		return 0, fmt.Errorf("unknown direction %q", s)
+	}
+}
@@ -0,0 +1,47 @@
+package geom
+
+import "testing"
+
+func TestDir(t *testing.T) {
+	tests := []struct {
+		s     string
+		want  Dir
+		right Dir
+		vec   Vec2[int]
+	}{
+		{"U", North, East, V2(0, -1)},
+		{">", East, South, V2(1, 0)},
+		{"v", South, West, V2(0, 1)},
+		{"W", West, North, V2(-1, 0)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			d, err := ParseDir(tt.s)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if d != tt.want {
+				t.Fatalf("ParseDir() = %v, want %v", d, tt.want)
+			}
+			if got := d.TurnRight(); got != tt.right {
+				t.Errorf("TurnRight() = %v, want %v", got, tt.right)
+			}
+			if got := d.TurnRight().TurnLeft(); got != d {
+				t.Errorf("TurnRight().TurnLeft() = %v, want %v", got, d)
+			}
+			if got := d.Vec(); got != tt.vec {
+				t.Errorf("Vec() = %v, want %v", got, tt.vec)
+			}
+			if got := d.Reverse().Vec(); got != tt.vec.Neg() {
+				t.Errorf("Reverse().Vec() = %v, want %v", got, tt.vec.Neg())
+			}
+		})
+	}
+
+	if _, err := ParseDir("X"); err == nil {
+		t.Error("ParseDir(X) succeeded")
+	}
+	if got := NorthWest.TurnRight(); got != NorthEast {
+		t.Errorf("NorthWest.TurnRight() = %v, want NE", got)
+	}
+}
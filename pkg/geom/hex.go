@@ -0,0 +1,81 @@
+package geom
+
+import "fmt"
+
+// Hex is a cell of a hexagonal grid in axial coordinates. The third cube
+// coordinate is S, so that Q + R + S is zero.
+type Hex struct {
+	Q, R int
+}
+
+func (h Hex) S() int {
+	return -h.Q - h.R
+}
+
+func (h Hex) Add(o Hex) Hex {
+	return Hex{h.Q + o.Q, h.R + o.R}
+}
+
+// Distance returns the number of steps between two cells.
+func (h Hex) Distance(o Hex) int {
+	return (Abs(h.Q-o.Q) + Abs(h.R-o.R) + Abs(h.S()-o.S())) / 2
+}
+
+// Neighbours returns the 6 cells around h.
+func (h Hex) Neighbours() []Hex {
+	hs := make([]Hex, 0, len(FlatHexDirs))
+	for _, name := range []string{"n", "ne", "se", "s", "sw", "nw"} {
+		hs = append(hs, h.Add(FlatHexDirs[name]))
+	}
+	return hs
+}
+
+// FlatHexDirs are the steps on a grid of hexagons with a flat top, which
+// have neighbours to the north and south.
+var FlatHexDirs = map[string]Hex{
+	"n":  {0, -1},
+	"ne": {1, -1},
+	"se": {1, 0},
+	"s":  {0, 1},
+	"sw": {-1, 1},
+	"nw": {-1, 0},
+}
+
+// PointyHexDirs are the steps on a grid of hexagons with a pointy top, which
+// have neighbours to the east and west.
+var PointyHexDirs = map[string]Hex{
+	"e":  {1, 0},
+	"ne": {1, -1},
+	"nw": {0, -1},
+	"w":  {-1, 0},
+	"sw": {-1, 1},
+	"se": {0, 1},
+}
+
+// ParseHexSteps parses steps with the directions in dirs, either without
+// separators, such as "nwwswee", or separated by commas, such as "ne,ne,s".
+func ParseHexSteps(s string, dirs map[string]Hex) ([]Hex, error) {
+	var steps []Hex
+	for i := 0; i < len(s); {
+		if s[i] == ',' {
+			i++
+			continue
+		}
+
+		if i+2 <= len(s) {
+			if h, ok := dirs[s[i:i+2]]; ok {
+				steps = append(steps, h)
+				i += 2
+				continue
+			}
+		}
+
+		h, ok := dirs[s[i:i+1]]
+		if !ok {
+			return nil, fmt.Errorf("unknown hex step at %d in %q", i, s)
+		}
+		steps = append(steps, h)
+		i++
+	}
+	return steps, nil
+}
@@ -0,0 +1,45 @@
+package geom
+
+// DoubleArea returns twice the area enclosed by a simple polygon, given its
+// vertices in order, by the shoelace formula. Twice the area of a polygon
+// with integer vertices is always an integer.
+// https://en.wikipedia.org/wiki/Shoelace_formula
+func DoubleArea[T Integer](vertices []Vec2[T]) T {
+	var sum T
+	for i, a := range vertices {
+		b := vertices[(i+1)%len(vertices)]
+		sum += a.X*b.Y - a.Y*b.X
+	}
+	return Abs(sum)
+}
+
+// BoundaryPoints returns the number of integer points on the edges of a
+// polygon, given its vertices in order.
+func BoundaryPoints[T Integer](vertices []Vec2[T]) T {
+	var n T
+	for i, a := range vertices {
+		d := vertices[(i+1)%len(vertices)].Sub(a)
+		n += gcd(Abs(d.X), Abs(d.Y))
+	}
+	return n
+}
+
+// InteriorPoints returns the number of integer points strictly inside a
+// simple polygon with integer vertices, by Pick's theorem.
+// https://en.wikipedia.org/wiki/Pick%27s_theorem
+func InteriorPoints[T Integer](vertices []Vec2[T]) T {
+	return (DoubleArea(vertices)-BoundaryPoints(vertices))/2 + 1
+}
+
+// LatticePoints returns the number of integer points inside or on a simple
+// polygon with integer vertices, such as the cells of a dug out lagoon.
+func LatticePoints[T Integer](vertices []Vec2[T]) T {
+	return InteriorPoints(vertices) + BoundaryPoints(vertices)
+}
+
+func gcd[T Integer](a, b T) T {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
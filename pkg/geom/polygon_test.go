@@ -0,0 +1,59 @@
+package geom
+
+import "testing"
+
+func TestPolygon(t *testing.T) {
+	tests := []struct {
+		name     string
+		vertices []Vec2[int]
+		area2    int
+		boundary int
+		interior int
+		lattice  int
+	}{
+		{
+			name:     "square",
+			vertices: []Vec2[int]{{0, 0}, {2, 0}, {2, 2}, {0, 2}},
+			area2:    8,
+			boundary: 8,
+			interior: 1,
+			lattice:  9,
+		},
+		{
+			name:     "counterclockwise triangle",
+			vertices: []Vec2[int]{{0, 0}, {0, 4}, {4, 0}},
+			area2:    16,
+			boundary: 12,
+			interior: 3,
+			lattice:  15,
+		},
+		{
+			// the lagoon of the example of 2023/18
+			name: "lagoon",
+			vertices: []Vec2[int]{
+				{0, 0}, {6, 0}, {6, 5}, {4, 5}, {4, 7}, {6, 7}, {6, 9}, {1, 9},
+				{1, 7}, {0, 7}, {0, 5}, {2, 5}, {2, 2}, {0, 2},
+			},
+			area2:    84,
+			boundary: 38,
+			interior: 24,
+			lattice:  62,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DoubleArea(tt.vertices); got != tt.area2 {
+				t.Errorf("DoubleArea() = %d, want %d", got, tt.area2)
+			}
+			if got := BoundaryPoints(tt.vertices); got != tt.boundary {
+				t.Errorf("BoundaryPoints() = %d, want %d", got, tt.boundary)
+			}
+			if got := InteriorPoints(tt.vertices); got != tt.interior {
+				t.Errorf("InteriorPoints() = %d, want %d", got, tt.interior)
+			}
+			if got := LatticePoints(tt.vertices); got != tt.lattice {
+				t.Errorf("LatticePoints() = %d, want %d", got, tt.lattice)
+			}
+		})
+	}
+}
@@ -0,0 +1,182 @@
+package geom
+
+// Rect is the axis-aligned rectangle of the cells from Min to Max, both
+// inclusive, as the puzzles describe ranges like "0,0 through 999,999".
+type Rect[T Integer] struct {
+	Min, Max Vec2[T]
+}
+
+// NewRect returns the rectangle with corners a and b.
+func NewRect[T Integer](a, b Vec2[T]) Rect[T] {
+	return Rect[T]{
+		Min: Vec2[T]{min(a.X, b.X), min(a.Y, b.Y)},
+		Max: Vec2[T]{max(a.X, b.X), max(a.Y, b.Y)},
+	}
+}
+
+// Bounds returns the smallest rectangle that contains every point.
+func Bounds[T Integer](points ...Vec2[T]) Rect[T] {
+	if len(points) == 0 {
+		return Rect[T]{Min: Vec2[T]{0, 0}, Max: Vec2[T]{-1, -1}}
+	}
+
+	r := Rect[T]{Min: points[0], Max: points[0]}
+	for _, p := range points[1:] {
+		r = r.Union(Rect[T]{Min: p, Max: p})
+	}
+	return r
+}
+
+// Empty reports whether the rectangle holds no cells.
+func (r Rect[T]) Empty() bool {
+	return r.Min.X > r.Max.X || r.Min.Y > r.Max.Y
+}
+
+func (r Rect[T]) Width() T {
+	return max(r.Max.X-r.Min.X+1, 0)
+}
+
+func (r Rect[T]) Height() T {
+	return max(r.Max.Y-r.Min.Y+1, 0)
+}
+
+// Area returns the number of cells.
+func (r Rect[T]) Area() T {
+	return r.Width() * r.Height()
+}
+
+func (r Rect[T]) Contains(p Vec2[T]) bool {
+	return p.X >= r.Min.X && p.X <= r.Max.X && p.Y >= r.Min.Y && p.Y <= r.Max.Y
+}
+
+// Intersect returns the cells in both rectangles, false when there are none.
+func (r Rect[T]) Intersect(o Rect[T]) (Rect[T], bool) {
+	i := Rect[T]{
+		Min: Vec2[T]{max(r.Min.X, o.Min.X), max(r.Min.Y, o.Min.Y)},
+		Max: Vec2[T]{min(r.Max.X, o.Max.X), min(r.Max.Y, o.Max.Y)},
+	}
+	return i, !i.Empty()
+}
+
+// Union returns the smallest rectangle that contains both rectangles.
+func (r Rect[T]) Union(o Rect[T]) Rect[T] {
+	switch {
+	case r.Empty():
+		return o
+	case o.Empty():
+		return r
+	}
+	return Rect[T]{
+		Min: Vec2[T]{min(r.Min.X, o.Min.X), min(r.Min.Y, o.Min.Y)},
+		Max: Vec2[T]{max(r.Max.X, o.Max.X), max(r.Max.Y, o.Max.Y)},
+	}
+}
+
+// Subtract returns the cells of r that are not in o as at most 4 disjoint
+// rectangles, so unions of overlapping rectangles can be counted exactly.
+func (r Rect[T]) Subtract(o Rect[T]) []Rect[T] {
+	i, ok := r.Intersect(o)
+	if !ok {
+		return []Rect[T]{r}
+	}
+
+	parts := []Rect[T]{
+		{Min: r.Min, Max: Vec2[T]{r.Max.X, i.Min.Y - 1}},                     // above
+		{Min: Vec2[T]{r.Min.X, i.Max.Y + 1}, Max: r.Max},                     // below
+		{Min: Vec2[T]{r.Min.X, i.Min.Y}, Max: Vec2[T]{i.Min.X - 1, i.Max.Y}}, // left
+		{Min: Vec2[T]{i.Max.X + 1, i.Min.Y}, Max: Vec2[T]{r.Max.X, i.Max.Y}}, // right
+	}
+
+	rest := parts[:0]
+	for _, p := range parts {
+		if !p.Empty() {
+			rest = append(rest, p)
+		}
+	}
+	return rest
+}
+
+// Cuboid is the axis-aligned box of the cells from Min to Max, both
+// inclusive.
+type Cuboid[T Integer] struct {
+	Min, Max Vec3[T]
+}
+
+// NewCuboid returns the cuboid with corners a and b.
+func NewCuboid[T Integer](a, b Vec3[T]) Cuboid[T] {
+	return Cuboid[T]{
+		Min: Vec3[T]{min(a.X, b.X), min(a.Y, b.Y), min(a.Z, b.Z)},
+		Max: Vec3[T]{max(a.X, b.X), max(a.Y, b.Y), max(a.Z, b.Z)},
+	}
+}
+
+// Empty reports whether the cuboid holds no cells.
+func (c Cuboid[T]) Empty() bool {
+	return c.Min.X > c.Max.X || c.Min.Y > c.Max.Y || c.Min.Z > c.Max.Z
+}
+
+// Volume returns the number of cells.
+func (c Cuboid[T]) Volume() T {
+	if c.Empty() {
+		return 0
+	}
+	return (c.Max.X - c.Min.X + 1) * (c.Max.Y - c.Min.Y + 1) * (c.Max.Z - c.Min.Z + 1)
+}
+
+func (c Cuboid[T]) Contains(p Vec3[T]) bool {
+	return p.X >= c.Min.X && p.X <= c.Max.X &&
+		p.Y >= c.Min.Y && p.Y <= c.Max.Y &&
+		p.Z >= c.Min.Z && p.Z <= c.Max.Z
+}
+
+// Intersect returns the cells in both cuboids, false when there are none.
+func (c Cuboid[T]) Intersect(o Cuboid[T]) (Cuboid[T], bool) {
+	i := Cuboid[T]{
+		Min: Vec3[T]{max(c.Min.X, o.Min.X), max(c.Min.Y, o.Min.Y), max(c.Min.Z, o.Min.Z)},
+		Max: Vec3[T]{min(c.Max.X, o.Max.X), min(c.Max.Y, o.Max.Y), min(c.Max.Z, o.Max.Z)},
+	}
+	return i, !i.Empty()
+}
+
+// Union returns the smallest cuboid that contains both cuboids.
+func (c Cuboid[T]) Union(o Cuboid[T]) Cuboid[T] {
+	switch {
+	case c.Empty():
+		return o
+	case o.Empty():
+		return c
+	}
+	return Cuboid[T]{
+		Min: Vec3[T]{min(c.Min.X, o.Min.X), min(c.Min.Y, o.Min.Y), min(c.Min.Z, o.Min.Z)},
+		Max: Vec3[T]{max(c.Max.X, o.Max.X), max(c.Max.Y, o.Max.Y), max(c.Max.Z, o.Max.Z)},
+	}
+}
+
+// Subtract returns the cells of c that are not in o as at most 6 disjoint
+// cuboids.
+func (c Cuboid[T]) Subtract(o Cuboid[T]) []Cuboid[T] {
+	i, ok := c.Intersect(o)
+	if !ok {
+		return []Cuboid[T]{c}
+	}
+
+	parts := []Cuboid[T]{
+		// slabs along x cover all of y and z
+		{Min: c.Min, Max: Vec3[T]{i.Min.X - 1, c.Max.Y, c.Max.Z}},
+		{Min: Vec3[T]{i.Max.X + 1, c.Min.Y, c.Min.Z}, Max: c.Max},
+		// within the x of the intersection, slabs along y cover all of z
+		{Min: Vec3[T]{i.Min.X, c.Min.Y, c.Min.Z}, Max: Vec3[T]{i.Max.X, i.Min.Y - 1, c.Max.Z}},
+		{Min: Vec3[T]{i.Min.X, i.Max.Y + 1, c.Min.Z}, Max: Vec3[T]{i.Max.X, c.Max.Y, c.Max.Z}},
+		// what remains is along z
+		{Min: Vec3[T]{i.Min.X, i.Min.Y, c.Min.Z}, Max: Vec3[T]{i.Max.X, i.Max.Y, i.Min.Z - 1}},
+		{Min: Vec3[T]{i.Min.X, i.Min.Y, i.Max.Z + 1}, Max: Vec3[T]{i.Max.X, i.Max.Y, c.Max.Z}},
+	}
+
+	rest := parts[:0]
+	for _, p := range parts {
+		if !p.Empty() {
+			rest = append(rest, p)
+		}
+	}
+	return rest
+}
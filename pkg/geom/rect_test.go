@@ -0,0 +1,75 @@
+package geom
+
+import "testing"
+
+func TestRect(t *testing.T) {
+	a := NewRect(V2(3, 3), V2(0, 0))
+	b := NewRect(V2(2, 2), V2(5, 4))
+
+	if got := a.Area(); got != 16 {
+		t.Errorf("Area() = %d, want 16", got)
+	}
+
+	i, ok := a.Intersect(b)
+	if want := NewRect(V2(2, 2), V2(3, 3)); !ok || i != want {
+		t.Errorf("Intersect() = %v, %v, want %v", i, ok, want)
+	}
+	if _, ok := a.Intersect(NewRect(V2(4, 0), V2(5, 1))); ok {
+		t.Error("Intersect() of disjoint rectangles")
+	}
+
+	if got, want := a.Union(b), NewRect(V2(0, 0), V2(5, 4)); got != want {
+		t.Errorf("Union() = %v, want %v", got, want)
+	}
+	if got, want := Bounds(V2(1, 5), V2(-2, 0), V2(3, 2)), NewRect(V2(-2, 0), V2(3, 5)); got != want {
+		t.Errorf("Bounds() = %v, want %v", got, want)
+	}
+	if !Bounds[int]().Empty() {
+		t.Error("Bounds() of no points is not empty")
+	}
+
+	// the area of the union of overlapping rectangles
+	area := b.Area()
+	for _, r := range a.Subtract(b) {
+		if _, ok := r.Intersect(b); ok {
+			t.Errorf("Subtract() part %v overlaps %v", r, b)
+		}
+		area += r.Area()
+	}
+	if area != 16+12-4 {
+		t.Errorf("area of union = %d, want %d", area, 16+12-4)
+	}
+}
+
+func TestCuboid(t *testing.T) {
+	a := NewCuboid(V3(0, 0, 0), V3(9, 9, 9))
+	b := NewCuboid(V3(5, 5, 5), V3(14, 14, 14))
+
+	if got := a.Volume(); got != 1000 {
+		t.Errorf("Volume() = %d, want 1000", got)
+	}
+
+	i, ok := a.Intersect(b)
+	if !ok || i.Volume() != 125 {
+		t.Errorf("Intersect() = %v, %v, want volume 125", i, ok)
+	}
+
+	rest := a.Subtract(b)
+	var volume int
+	for _, c := range rest {
+		if _, ok := c.Intersect(b); ok {
+			t.Errorf("Subtract() part %v overlaps %v", c, b)
+		}
+		volume += c.Volume()
+	}
+	if volume != 1000-125 {
+		t.Errorf("volume of difference = %d, want %d", volume, 1000-125)
+	}
+
+	if got := a.Union(b); got != NewCuboid(V3(0, 0, 0), V3(14, 14, 14)) {
+		t.Errorf("Union() = %v", got)
+	}
+	if !a.Contains(V3(9, 0, 5)) || a.Contains(V3(10, 0, 5)) {
+		t.Error("Contains() is wrong at the border")
+	}
+}
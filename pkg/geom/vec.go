@@ -0,0 +1,126 @@
+// Package geom implements the integer geometry of the puzzles: vectors,
+// directions, distances, rectangles and polygons.
+package geom
+
+import "fmt"
+
+// Integer is the constraint of the coordinates.
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64
+}
+
+// Abs returns the absolute value of v.
+func Abs[T Integer](v T) T {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
+
+// Vec2 is a position or offset in the plane. On screens and grids Y grows
+// downwards, so North is {0, -1}.
+type Vec2[T Integer] struct {
+	X, Y T
+}
+
+// V2 returns the vector {x, y}.
+func V2[T Integer](x, y T) Vec2[T] {
+	return Vec2[T]{x, y}
+}
+
+func (v Vec2[T]) Add(o Vec2[T]) Vec2[T] {
+	return Vec2[T]{v.X + o.X, v.Y + o.Y}
+}
+
+func (v Vec2[T]) Sub(o Vec2[T]) Vec2[T] {
+	return Vec2[T]{v.X - o.X, v.Y - o.Y}
+}
+
+func (v Vec2[T]) Mul(n T) Vec2[T] {
+	return Vec2[T]{v.X * n, v.Y * n}
+}
+
+func (v Vec2[T]) Neg() Vec2[T] {
+	return Vec2[T]{-v.X, -v.Y}
+}
+
+// Move returns v moved n steps in direction d.
+func (v Vec2[T]) Move(d Dir, n T) Vec2[T] {
+	o := d.Vec()
+	return Vec2[T]{v.X + T(o.X)*n, v.Y + T(o.Y)*n}
+}
+
+// RotateRight returns v turned a quarter clockwise around the origin.
+func (v Vec2[T]) RotateRight() Vec2[T] {
+	return Vec2[T]{-v.Y, v.X}
+}
+
+// RotateLeft returns v turned a quarter counterclockwise around the origin.
+func (v Vec2[T]) RotateLeft() Vec2[T] {
+	return Vec2[T]{v.Y, -v.X}
+}
+
+// Manhattan returns the Manhattan length of v, |x| + |y|.
+func (v Vec2[T]) Manhattan() T {
+	return Abs(v.X) + Abs(v.Y)
+}
+
+// Chebyshev returns the Chebyshev length of v, max(|x|, |y|).
+func (v Vec2[T]) Chebyshev() T {
+	return max(Abs(v.X), Abs(v.Y))
+}
+
+func (v Vec2[T]) String() string {
+	return fmt.Sprintf("(%d, %d)", v.X, v.Y)
+}
+
+// Vec3 is a position or offset in space.
+type Vec3[T Integer] struct {
+	X, Y, Z T
+}
+
+// V3 returns the vector {x, y, z}.
+func V3[T Integer](x, y, z T) Vec3[T] {
+	return Vec3[T]{x, y, z}
+}
+
+func (v Vec3[T]) Add(o Vec3[T]) Vec3[T] {
+	return Vec3[T]{v.X + o.X, v.Y + o.Y, v.Z + o.Z}
+}
+
+func (v Vec3[T]) Sub(o Vec3[T]) Vec3[T] {
+	return Vec3[T]{v.X - o.X, v.Y - o.Y, v.Z - o.Z}
+}
+
+func (v Vec3[T]) Mul(n T) Vec3[T] {
+	return Vec3[T]{v.X * n, v.Y * n, v.Z * n}
+}
+
+func (v Vec3[T]) Neg() Vec3[T] {
+	return Vec3[T]{-v.X, -v.Y, -v.Z}
+}
+
+// Manhattan returns the Manhattan length of v, |x| + |y| + |z|.
+func (v Vec3[T]) Manhattan() T {
+	return Abs(v.X) + Abs(v.Y) + Abs(v.Z)
+}
+
+// Chebyshev returns the Chebyshev length of v, max(|x|, |y|, |z|).
+func (v Vec3[T]) Chebyshev() T {
+	return max(Abs(v.X), Abs(v.Y), Abs(v.Z))
+}
+
+func (v Vec3[T]) String() string {
+	return fmt.Sprintf("(%d, %d, %d)", v.X, v.Y, v.Z)
+}
+
+// Manhattan returns the Manhattan distance between a and b.
+func Manhattan[T Integer](a, b Vec2[T]) T {
+	return a.Sub(b).Manhattan()
+}
+
+// Chebyshev returns the Chebyshev distance between a and b, the number of
+// king moves from a to b.
+func Chebyshev[T Integer](a, b Vec2[T]) T {
+	return a.Sub(b).Chebyshev()
+}
@@ -0,0 +1,81 @@
+package geom
+
+import "testing"
+
+func TestVec2(t *testing.T) {
+	v := V2(3, -4)
+
+	if got := v.Manhattan(); got != 7 {
+		t.Errorf("Manhattan() = %d, want 7", got)
+	}
+	if got := v.Chebyshev(); got != 4 {
+		t.Errorf("Chebyshev() = %d, want 4", got)
+	}
+	if got, want := v.RotateRight(), V2(4, 3); got != want {
+		t.Errorf("RotateRight() = %v, want %v", got, want)
+	}
+	if got := v.RotateLeft().RotateRight(); got != v {
+		t.Errorf("RotateLeft().RotateRight() = %v, want %v", got, v)
+	}
+	if got, want := v.Move(North, 2), V2(3, -6); got != want {
+		t.Errorf("Move(North, 2) = %v, want %v", got, want)
+	}
+	if got := Manhattan(V2[int64](1, 1), V2[int64](-2, 5)); got != 7 {
+		t.Errorf("Manhattan() = %d, want 7", got)
+	}
+	if got := Chebyshev(V2(1, 1), V2(-2, 5)); got != 4 {
+		t.Errorf("Chebyshev() = %d, want 4", got)
+	}
+}
+
+func TestVec3(t *testing.T) {
+	a, b := V3(1, 2, 3), V3(-1, 0, 7)
+
+	if got := a.Sub(b).Manhattan(); got != 8 {
+		t.Errorf("Manhattan() = %d, want 8", got)
+	}
+	if got := a.Sub(b).Chebyshev(); got != 4 {
+		t.Errorf("Chebyshev() = %d, want 4", got)
+	}
+	if got, want := a.Add(b.Neg()).Mul(2), V3(4, 4, -8); got != want {
+		t.Errorf("Add().Mul() = %v, want %v", got, want)
+	}
+}
+
+func TestHex(t *testing.T) {
+	tests := []struct {
+		steps string
+		dirs  map[string]Hex
+		want  int
+	}{
+		{"ne,ne,ne", FlatHexDirs, 3},
+		{"ne,ne,sw,sw", FlatHexDirs, 0},
+		{"ne,ne,s,s", FlatHexDirs, 2},
+		{"se,sw,se,sw,sw", FlatHexDirs, 3},
+		{"nwwswee", PointyHexDirs, 0},
+		{"esew", PointyHexDirs, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.steps, func(t *testing.T) {
+			steps, err := ParseHexSteps(tt.steps, tt.dirs)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var h Hex
+			for _, s := range steps {
+				h = h.Add(s)
+			}
+
+			if got := h.Distance(Hex{}); got != tt.want {
+				t.Errorf("Distance() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+
+	for _, n := range (Hex{2, -1}).Neighbours() {
+		if d := n.Distance(Hex{2, -1}); d != 1 {
+			t.Errorf("neighbour %v at distance %d", n, d)
+		}
+	}
+}
@@ -0,0 +1,390 @@
+// Package grid implements the rectangular grids of cells most puzzles are
+// played on.
+package grid
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"hash/maphash"
+	"io"
+	"strings"
+
+	"github.com/pimvanhespen/advent-of-code/pkg/geom"
+)
+
+// Point is the position of a cell, X grows to the right and Y downwards.
+type Point = geom.Vec2[int]
+
+var (
+	Up    = Point{X: 0, Y: -1}
+	Right = Point{X: 1, Y: 0}
+	Down  = Point{X: 0, Y: 1}
+	Left  = Point{X: -1, Y: 0}
+)
+
+// Orthogonal are the offsets of the 4 neighbours of a cell, clockwise from Up.
+var Orthogonal = []Point{Up, Right, Down, Left}
+
+// Adjacent are the offsets of the 8 neighbours of a cell, clockwise from Up.
+var Adjacent = []Point{Up, {X: 1, Y: -1}, Right, {X: 1, Y: 1}, Down, {X: -1, Y: 1}, Left, {X: -1, Y: -1}}
+
+// Grid is a rectangular grid of cells stored row by row. Grids are values
+// that share their cells, like slices; use Clone for an independent copy.
+type Grid[T any] struct {
+	width, height int
+	cells         []T
+}
+
+// New returns a grid of zero cells.
+func New[T any](width, height int) Grid[T] {
+	return Grid[T]{
+		width:  width,
+		height: height,
+		cells:  make([]T, width*height),
+	}
+}
+
+// FromRows returns a grid with a copy of rows, which must have equal lengths.
+func FromRows[T any](rows [][]T) (Grid[T], error) {
+	if len(rows) == 0 {
+		return Grid[T]{}, nil
+	}
+
+	g := New[T](len(rows[0]), len(rows))
+	for y, row := range rows {
+		if len(row) != g.width {
+			return Grid[T]{}, fmt.Errorf("row %d has %d cells, want %d", y, len(row), g.width)
+		}
+		copy(g.Row(y), row)
+	}
+
+	return g, nil
+}
+
+// Parse reads a grid with a cell per byte and a row per line. Empty lines
+// around the grid are ignored, anything after them is an error: inputs of
+// several grids are parsed with aoc.ParseSections and aoc.GridSection.
+func Parse[T any](r io.Reader, cell func(b byte) (T, error)) (Grid[T], error) {
+	var g Grid[T]
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 1<<20)
+
+	var line int
+	var ended bool // an empty line followed the grid
+	for scanner.Scan() {
+		line++
+
+		text := strings.TrimRight(scanner.Text(), "\r")
+		if text == "" {
+			ended = g.height > 0
+			continue
+		}
+		if ended {
+			return Grid[T]{}, fmt.Errorf("line %d: unexpected content after the grid", line)
+		}
+
+		if g.height == 0 {
+			g.width = len(text)
+		}
+		if len(text) != g.width {
+			return Grid[T]{}, fmt.Errorf("line %d: %d cells, want %d", line, len(text), g.width)
+		}
+
+		for i := 0; i < len(text); i++ {
+			v, err := cell(text[i])
+			if err != nil {
+				return Grid[T]{}, fmt.Errorf("line %d, column %d: %w", line, i+1, err)
+			}
+			g.cells = append(g.cells, v)
+		}
+		g.height++
+	}
+
+	if err := scanner.Err(); err != nil {
+		return Grid[T]{}, err
+	}
+
+	return g, nil
+}
+
+// Byte is the cell function of Parse for grids of bytes.
+func Byte(b byte) (byte, error) {
+	return b, nil
+}
+
+// Width returns the number of columns.
+func (g Grid[T]) Width() int {
+	return g.width
+}
+
+// Height returns the number of rows.
+func (g Grid[T]) Height() int {
+	return g.height
+}
+
+// Size returns the width and height.
+func (g Grid[T]) Size() (width, height int) {
+	return g.width, g.height
+}
+
+// In reports whether p is inside the grid.
+func (g Grid[T]) In(p Point) bool {
+	return p.X >= 0 && p.X < g.width && p.Y >= 0 && p.Y < g.height
+}
+
+// Get returns the cell at p, or false when p is outside the grid.
+func (g Grid[T]) Get(p Point) (T, bool) {
+	if !g.In(p) {
+		var zero T
+		return zero, false
+	}
+	return g.cells[p.Y*g.width+p.X], true
+}
+
+// At returns the cell at p and panics when p is outside the grid.
+func (g Grid[T]) At(p Point) T {
+	if !g.In(p) {
+		panic(fmt.Sprintf("grid: %v outside %dx%d grid", p, g.width, g.height))
+	}
+	return g.cells[p.Y*g.width+p.X]
+}
+
+// Set sets the cell at p, it reports false when p is outside the grid.
+func (g Grid[T]) Set(p Point, v T) bool {
+	if !g.In(p) {
+		return false
+	}
+	g.cells[p.Y*g.width+p.X] = v
+	return true
+}
+
+// Fill sets every cell to v.
+func (g Grid[T]) Fill(v T) {
+	for i := range g.cells {
+		g.cells[i] = v
+	}
+}
+
+// Row returns row y, which shares its cells with the grid.
+func (g Grid[T]) Row(y int) []T {
+	return g.cells[y*g.width : (y+1)*g.width : (y+1)*g.width]
+}
+
+// Column returns a copy of column x.
+func (g Grid[T]) Column(x int) []T {
+	col := make([]T, g.height)
+	for y := range col {
+		col[y] = g.cells[y*g.width+x]
+	}
+	return col
+}
+
+// Neighbours4 returns the orthogonal neighbours of p inside the grid.
+func (g Grid[T]) Neighbours4(p Point) []Point {
+	return g.neighbours(p, Orthogonal)
+}
+
+// Neighbours8 returns the orthogonal and diagonal neighbours of p inside the
+// grid.
+func (g Grid[T]) Neighbours8(p Point) []Point {
+	return g.neighbours(p, Adjacent)
+}
+
+func (g Grid[T]) neighbours(p Point, offsets []Point) []Point {
+	points := make([]Point, 0, len(offsets))
+	for _, o := range offsets {
+		if q := p.Add(o); g.In(q) {
+			points = append(points, q)
+		}
+	}
+	return points
+}
+
+// Each calls fn for every cell, row by row.
+func (g Grid[T]) Each(fn func(p Point, v T)) {
+	for i, v := range g.cells {
+		fn(Point{X: i % g.width, Y: i / g.width}, v)
+	}
+}
+
+// Find returns the first cell, row by row, for which match returns true.
+func (g Grid[T]) Find(match func(T) bool) (Point, bool) {
+	for i, v := range g.cells {
+		if match(v) {
+			return Point{X: i % g.width, Y: i / g.width}, true
+		}
+	}
+	return Point{}, false
+}
+
+// FindAll returns every cell, row by row, for which match returns true.
+func (g Grid[T]) FindAll(match func(T) bool) []Point {
+	var points []Point
+	for i, v := range g.cells {
+		if match(v) {
+			points = append(points, Point{X: i % g.width, Y: i / g.width})
+		}
+	}
+	return points
+}
+
+// Count returns the number of cells for which match returns true.
+func (g Grid[T]) Count(match func(T) bool) int {
+	var n int
+	for _, v := range g.cells {
+		if match(v) {
+			n++
+		}
+	}
+	return n
+}
+
+// Clone returns a copy of the grid.
+func (g Grid[T]) Clone() Grid[T] {
+	return Grid[T]{
+		width:  g.width,
+		height: g.height,
+		cells:  append([]T(nil), g.cells...),
+	}
+}
+
+// Sub returns a copy of the width by height cells starting at min.
+func (g Grid[T]) Sub(min Point, width, height int) Grid[T] {
+	if !g.In(min) || !g.In(Point{X: min.X + width - 1, Y: min.Y + height - 1}) {
+		panic(fmt.Sprintf("grid: %dx%d at %v outside %dx%d grid", width, height, min, g.width, g.height))
+	}
+
+	sub := New[T](width, height)
+	for y := 0; y < height; y++ {
+		copy(sub.Row(y), g.Row(min.Y + y)[min.X:min.X+width])
+	}
+	return sub
+}
+
+// transform returns a width by height grid with the cell at p taken from
+// the cell at from(p).
+func (g Grid[T]) transform(width, height int, from func(p Point) Point) Grid[T] {
+	t := New[T](width, height)
+	for i := range t.cells {
+		src := from(Point{X: i % width, Y: i / width})
+		t.cells[i] = g.cells[src.Y*g.width+src.X]
+	}
+	return t
+}
+
+// RotateRight returns the grid rotated a quarter turn clockwise.
+func (g Grid[T]) RotateRight() Grid[T] {
+	return g.transform(g.height, g.width, func(p Point) Point {
+		return Point{X: p.Y, Y: g.height - 1 - p.X}
+	})
+}
+
+// RotateLeft returns the grid rotated a quarter turn counterclockwise.
+func (g Grid[T]) RotateLeft() Grid[T] {
+	return g.transform(g.height, g.width, func(p Point) Point {
+		return Point{X: g.width - 1 - p.Y, Y: p.X}
+	})
+}
+
+// Rotate180 returns the grid rotated half a turn.
+func (g Grid[T]) Rotate180() Grid[T] {
+	return g.transform(g.width, g.height, func(p Point) Point {
+		return Point{X: g.width - 1 - p.X, Y: g.height - 1 - p.Y}
+	})
+}
+
+// FlipHorizontal returns the grid mirrored left to right.
+func (g Grid[T]) FlipHorizontal() Grid[T] {
+	return g.transform(g.width, g.height, func(p Point) Point {
+		return Point{X: g.width - 1 - p.X, Y: p.Y}
+	})
+}
+
+// FlipVertical returns the grid mirrored top to bottom.
+func (g Grid[T]) FlipVertical() Grid[T] {
+	return g.transform(g.width, g.height, func(p Point) Point {
+		return Point{X: p.X, Y: g.height - 1 - p.Y}
+	})
+}
+
+// Transpose returns the grid mirrored along its main diagonal.
+func (g Grid[T]) Transpose() Grid[T] {
+	return g.transform(g.height, g.width, func(p Point) Point {
+		return Point{X: p.Y, Y: p.X}
+	})
+}
+
+// Equal reports whether two grids have the same size and cells.
+func Equal[T comparable](a, b Grid[T]) bool {
+	if a.width != b.width || a.height != b.height {
+		return false
+	}
+	for i := range a.cells {
+		if a.cells[i] != b.cells[i] {
+			return false
+		}
+	}
+	return true
+}
+
+var seed = maphash.MakeSeed()
+
+// Hash returns a hash of the size and cells of the grid, to detect repeated
+// states. Hashes are only stable within a process.
+func (g Grid[T]) Hash() uint64 {
+	var h maphash.Hash
+	h.SetSeed(seed)
+
+	var size [16]byte
+	binary.LittleEndian.PutUint64(size[:8], uint64(g.width))
+	binary.LittleEndian.PutUint64(size[8:], uint64(g.height))
+	_, _ = h.Write(size[:])
+
+	switch cells := any(g.cells).(type) {
+	case []byte:
+		_, _ = h.Write(cells)
+	case []bool:
+		for _, c := range cells {
+			if c {
+				_ = h.WriteByte(1)
+			} else {
+				_ = h.WriteByte(0)
+			}
+		}
+	default:
+		for _, c := range g.cells {
+			_, _ = fmt.Fprint(&h, c)
+			_ = h.WriteByte(0)
+		}
+	}
+
+	return h.Sum64()
+}
+
+// String renders the grid a row per line. Bytes and runes are written as
+// characters, booleans as # and . and other cells with %v.
+func (g Grid[T]) String() string {
+	var sb strings.Builder
+	for y := 0; y < g.height; y++ {
+		for _, c := range g.Row(y) {
+			switch v := any(c).(type) {
+			case byte:
+				sb.WriteByte(v)
+			case rune:
+				sb.WriteRune(v)
+			case bool:
+				if v {
+					sb.WriteByte('#')
+				} else {
+					sb.WriteByte('.')
+				}
+			default:
+				_, _ = fmt.Fprint(&sb, v)
+			}
+		}
+		sb.WriteByte('\n')
+	}
+	return sb.String()
+}
@@ -0,0 +1,159 @@
+package grid
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func mustParse(t *testing.T, s string) Grid[byte] {
+	t.Helper()
+
+	g, err := Parse(strings.NewReader(s), Byte)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return g
+}
+
+func TestParse(t *testing.T) {
+	errBad := errors.New("bad cell")
+	cell := func(b byte) (bool, error) {
+		switch b {
+		case '#':
+			return true, nil
+		case '.':
+			return false, nil
+		}
+		return false, errBad
+	}
+
+	tests := []struct {
+		name    string
+		input   string
+		want    string
+		wantErr string
+	}{
+		{name: "grid", input: "\n#..\n.#.\r\n\n", want: "#..\n.#.\n"},
+		{name: "empty", input: "", want: ""},
+		{name: "ragged", input: "#..\n.#\n", wantErr: "line 2: 2 cells, want 3"},
+		{name: "cell", input: "#..\n.x.\n", wantErr: "line 2, column 2: bad cell"},
+		{name: "trailing", input: "#.\n.#\n\n##\n", wantErr: "line 4: unexpected content after the grid"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g, err := Parse(strings.NewReader(tt.input), cell)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("Parse() error = %v, want %s", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := g.String(); got != tt.want {
+				t.Errorf("Parse() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGrid_transform(t *testing.T) {
+	g := mustParse(t, "abc\ndef\n")
+
+	tests := []struct {
+		name string
+		fn   func(Grid[byte]) Grid[byte]
+		want string
+	}{
+		{"RotateRight", Grid[byte].RotateRight, "da\neb\nfc\n"},
+		{"RotateLeft", Grid[byte].RotateLeft, "cf\nbe\nad\n"},
+		{"Rotate180", Grid[byte].Rotate180, "fed\ncba\n"},
+		{"FlipHorizontal", Grid[byte].FlipHorizontal, "cba\nfed\n"},
+		{"FlipVertical", Grid[byte].FlipVertical, "def\nabc\n"},
+		{"Transpose", Grid[byte].Transpose, "ad\nbe\ncf\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.fn(g).String(); got != tt.want {
+				t.Errorf("%s() = %q, want %q", tt.name, got, tt.want)
+			}
+		})
+	}
+
+	if got := g.RotateRight().RotateLeft(); !Equal(got, g) {
+		t.Errorf("RotateRight().RotateLeft() = %q, want %q", got, g)
+	}
+}
+
+func TestGrid_access(t *testing.T) {
+	g := mustParse(t, "abc\ndef\nghi\n")
+
+	if v, ok := g.Get(Point{X: 2, Y: 1}); !ok || v != 'f' {
+		t.Errorf("Get(2, 1) = %c, %v", v, ok)
+	}
+	if _, ok := g.Get(Point{X: 3, Y: 0}); ok {
+		t.Error("Get(3, 0) is inside the grid")
+	}
+	if g.Set(Point{X: -1, Y: 0}, 'x') {
+		t.Error("Set(-1, 0) is inside the grid")
+	}
+
+	if got := string(g.Column(1)); got != "beh" {
+		t.Errorf("Column(1) = %q", got)
+	}
+
+	g.Row(0)[0] = 'A'
+	if got := g.At(Point{X: 0, Y: 0}); got != 'A' {
+		t.Errorf("Row() does not share cells, At(0, 0) = %c", got)
+	}
+
+	if got := g.Sub(Point{X: 1, Y: 1}, 2, 2).String(); got != "ef\nhi\n" {
+		t.Errorf("Sub() = %q", got)
+	}
+
+	wantN4 := []Point{{X: 1, Y: 0}, {X: 2, Y: 1}, {X: 1, Y: 2}, {X: 0, Y: 1}}
+	if got := g.Neighbours4(Point{X: 1, Y: 1}); !reflect.DeepEqual(got, wantN4) {
+		t.Errorf("Neighbours4() = %v, want %v", got, wantN4)
+	}
+	wantN8 := []Point{{X: 1, Y: 0}, {X: 1, Y: 1}, {X: 0, Y: 1}}
+	if got := g.Neighbours8(Point{X: 0, Y: 0}); !reflect.DeepEqual(got, wantN8) {
+		t.Errorf("Neighbours8() = %v, want %v", got, wantN8)
+	}
+}
+
+func TestGrid_search(t *testing.T) {
+	g := mustParse(t, "#.#\n..#\n")
+	lit := func(b byte) bool { return b == '#' }
+
+	if p, ok := g.Find(lit); !ok || p != (Point{X: 0, Y: 0}) {
+		t.Errorf("Find() = %v, %v", p, ok)
+	}
+	if got, want := g.FindAll(lit), []Point{{X: 0, Y: 0}, {X: 2, Y: 0}, {X: 2, Y: 1}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("FindAll() = %v, want %v", got, want)
+	}
+	if got := g.Count(lit); got != 3 {
+		t.Errorf("Count() = %d, want 3", got)
+	}
+}
+
+func TestGrid_Hash(t *testing.T) {
+	a := mustParse(t, "ab\ncd\n")
+	b := a.Clone()
+
+	if a.Hash() != b.Hash() || !Equal(a, b) {
+		t.Error("clones differ")
+	}
+
+	b.Set(Point{X: 1, Y: 1}, 'x')
+	if a.Hash() == b.Hash() || Equal(a, b) {
+		t.Error("Clone() shares cells")
+	}
+
+	// same cells, other shape
+	if a.Hash() == mustParse(t, "abcd\n").Hash() {
+		t.Error("Hash() ignores the size")
+	}
+}
@@ -0,0 +1,69 @@
+// Package interval implements ranges of integers and normalized sets of
+// them, to solve range puzzles without visiting every number.
+package interval
+
+import "fmt"
+
+// Integer is the constraint of the bounds.
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64
+}
+
+// Interval is the half-open range of integers [From, To).
+type Interval[T Integer] struct {
+	From, To T
+}
+
+// Closed returns the interval of min through max, as puzzles write ranges
+// like "5-8".
+func Closed[T Integer](min, max T) Interval[T] {
+	return Interval[T]{From: min, To: max + 1}
+}
+
+// Span returns the interval of n integers starting at start.
+func Span[T Integer](start, n T) Interval[T] {
+	return Interval[T]{From: start, To: start + n}
+}
+
+// Empty reports whether the interval holds no integers.
+func (i Interval[T]) Empty() bool {
+	return i.From >= i.To
+}
+
+// Len returns the number of integers in the interval.
+func (i Interval[T]) Len() T {
+	if i.Empty() {
+		return 0
+	}
+	return i.To - i.From
+}
+
+func (i Interval[T]) Contains(v T) bool {
+	return v >= i.From && v < i.To
+}
+
+// Last returns the largest integer in the interval.
+func (i Interval[T]) Last() T {
+	return i.To - 1
+}
+
+// Overlaps reports whether the intervals share an integer.
+func (i Interval[T]) Overlaps(o Interval[T]) bool {
+	return i.From < o.To && o.From < i.To && !i.Empty() && !o.Empty()
+}
+
+// Intersect returns the integers in both intervals, false when there are
+// none.
+func (i Interval[T]) Intersect(o Interval[T]) (Interval[T], bool) {
+	r := Interval[T]{From: max(i.From, o.From), To: min(i.To, o.To)}
+	return r, !r.Empty()
+}
+
+// Shift returns the interval moved by d.
+func (i Interval[T]) Shift(d T) Interval[T] {
+	return Interval[T]{From: i.From + d, To: i.To + d}
+}
+
+func (i Interval[T]) String() string {
+	return fmt.Sprintf("[%d, %d)", i.From, i.To)
+}
@@ -0,0 +1,160 @@
+package interval
+
+import (
+	"sort"
+	"strings"
+)
+
+// Set is a set of integers stored as sorted, disjoint and non-adjacent
+// intervals. Sets are immutable; operations return new sets.
+type Set[T Integer] struct {
+	intervals []Interval[T]
+}
+
+// NewSet returns the union of the intervals.
+func NewSet[T Integer](intervals ...Interval[T]) Set[T] {
+	ivs := make([]Interval[T], 0, len(intervals))
+	for _, i := range intervals {
+		if !i.Empty() {
+			ivs = append(ivs, i)
+		}
+	}
+
+	sort.Slice(ivs, func(a, b int) bool {
+		return ivs[a].From < ivs[b].From
+	})
+
+	// merge overlapping and adjacent intervals, 1-3 and 4-5 are 1-5
+	merged := ivs[:0]
+	for _, i := range ivs {
+		if n := len(merged); n > 0 && i.From <= merged[n-1].To {
+			merged[n-1].To = max(merged[n-1].To, i.To)
+			continue
+		}
+		merged = append(merged, i)
+	}
+
+	return Set[T]{intervals: merged}
+}
+
+// Intervals returns the intervals of the set in order.
+func (s Set[T]) Intervals() []Interval[T] {
+	return append([]Interval[T](nil), s.intervals...)
+}
+
+// Empty reports whether the set holds no integers.
+func (s Set[T]) Empty() bool {
+	return len(s.intervals) == 0
+}
+
+// Len returns the number of integers in the set.
+func (s Set[T]) Len() T {
+	var n T
+	for _, i := range s.intervals {
+		n += i.Len()
+	}
+	return n
+}
+
+// Min returns the smallest integer in the set, false when it is empty.
+func (s Set[T]) Min() (T, bool) {
+	if s.Empty() {
+		return 0, false
+	}
+	return s.intervals[0].From, true
+}
+
+// Max returns the largest integer in the set, false when it is empty.
+func (s Set[T]) Max() (T, bool) {
+	if s.Empty() {
+		return 0, false
+	}
+	return s.intervals[len(s.intervals)-1].Last(), true
+}
+
+func (s Set[T]) Contains(v T) bool {
+	i := sort.Search(len(s.intervals), func(i int) bool {
+		return s.intervals[i].To > v
+	})
+	return i < len(s.intervals) && s.intervals[i].Contains(v)
+}
+
+// Union returns the integers in either set.
+func (s Set[T]) Union(o Set[T]) Set[T] {
+	return NewSet(append(s.Intervals(), o.intervals...)...)
+}
+
+// Intersect returns the integers in both sets.
+func (s Set[T]) Intersect(o Set[T]) Set[T] {
+	var ivs []Interval[T]
+
+	// both are sorted, so walk them side by side
+	for a, b := 0, 0; a < len(s.intervals) && b < len(o.intervals); {
+		x, y := s.intervals[a], o.intervals[b]
+		if i, ok := x.Intersect(y); ok {
+			ivs = append(ivs, i)
+		}
+		if x.To < y.To {
+			a++
+		} else {
+			b++
+		}
+	}
+
+	return Set[T]{intervals: ivs}
+}
+
+// Difference returns the integers in s that are not in o.
+func (s Set[T]) Difference(o Set[T]) Set[T] {
+	if s.Empty() || o.Empty() {
+		return s
+	}
+
+	lo, _ := s.Min()
+	hi, _ := s.Max()
+	return s.Intersect(o.Complement(Closed(lo, hi)))
+}
+
+// Complement returns the integers within bounds that are not in the set.
+func (s Set[T]) Complement(bounds Interval[T]) Set[T] {
+	var ivs []Interval[T]
+
+	from := bounds.From
+	for _, i := range s.intervals {
+		if i.From > from {
+			ivs = append(ivs, Interval[T]{From: from, To: min(i.From, bounds.To)})
+		}
+		from = max(from, i.To)
+	}
+	ivs = append(ivs, Interval[T]{From: from, To: bounds.To})
+
+	return NewSet(ivs...)
+}
+
+// Split returns the intervals of the set cut at every boundary, a boundary b
+// ends an interval at b and starts the next at b.
+func (s Set[T]) Split(boundaries ...T) []Interval[T] {
+	bs := append([]T(nil), boundaries...)
+	sort.Slice(bs, func(i, j int) bool { return bs[i] < bs[j] })
+
+	var ivs []Interval[T]
+	for _, i := range s.intervals {
+		from := i.From
+		for _, b := range bs {
+			if b > from && b < i.To {
+				ivs = append(ivs, Interval[T]{From: from, To: b})
+				from = b
+			}
+		}
+		ivs = append(ivs, Interval[T]{From: from, To: i.To})
+	}
+	return ivs
+}
+
+func (s Set[T]) String() string {
+	parts := make([]string, len(s.intervals))
+	for i, iv := range s.intervals {
+		parts[i] = iv.String()
+	}
+	return "{" + strings.Join(parts, " ") + "}"
+}
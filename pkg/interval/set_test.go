@@ -0,0 +1,118 @@
+package interval
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNewSet(t *testing.T) {
+	tests := []struct {
+		name      string
+		intervals []Interval[int]
+		want      []Interval[int]
+	}{
+		{
+			name:      "merge",
+			intervals: []Interval[int]{Closed(5, 8), Closed(0, 2), Closed(4, 7)},
+			want:      []Interval[int]{{0, 3}, {4, 9}},
+		},
+		{
+			name:      "adjacent",
+			intervals: []Interval[int]{Closed(1, 3), Closed(4, 5)},
+			want:      []Interval[int]{{1, 6}},
+		},
+		{
+			name:      "empty",
+			intervals: []Interval[int]{{3, 3}, {5, 1}},
+			want:      nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewSet(tt.intervals...).Intervals(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewSet() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSet_operations(t *testing.T) {
+	a := NewSet(Span(0, 10), Span(20, 10)) // [0, 10) [20, 30)
+	b := NewSet(Span(5, 20), Span(28, 10)) // [5, 25) [28, 38)
+
+	tests := []struct {
+		name string
+		got  Set[int]
+		want []Interval[int]
+	}{
+		{"Union", a.Union(b), []Interval[int]{{0, 38}}},
+		{"Intersect", a.Intersect(b), []Interval[int]{{5, 10}, {20, 25}, {28, 30}}},
+		{"Difference", a.Difference(b), []Interval[int]{{0, 5}, {25, 28}}},
+		{"Complement", a.Complement(Span(-5, 40)), []Interval[int]{{-5, 0}, {10, 20}, {30, 35}}},
+		{"Complement inside", a.Complement(Span(2, 5)), nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.got.Intervals(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("%s() = %v, want %v", tt.name, got, tt.want)
+			}
+		})
+	}
+
+	if got := a.Len(); got != 20 {
+		t.Errorf("Len() = %d, want 20", got)
+	}
+	if lo, _ := b.Min(); lo != 5 {
+		t.Errorf("Min() = %d, want 5", lo)
+	}
+	if hi, _ := b.Max(); hi != 37 {
+		t.Errorf("Max() = %d, want 37", hi)
+	}
+	for v, want := range map[int]bool{-1: false, 0: true, 9: true, 10: false, 25: true, 30: false} {
+		if got := a.Contains(v); got != want {
+			t.Errorf("Contains(%d) = %v, want %v", v, got, want)
+		}
+	}
+}
+
+func TestSet_Split(t *testing.T) {
+	s := NewSet(Span(0, 10), Span(20, 10))
+
+	got := s.Split(25, 5, 10, 0, 40)
+	want := []Interval[int]{{0, 5}, {5, 10}, {20, 25}, {25, 30}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Split() = %v, want %v", got, want)
+	}
+}
+
+func TestTable_MapSet(t *testing.T) {
+	// the seed-to-soil map of the example of 2023/05
+	table := Table[int]{
+		{Src: Span(98, 2), Delta: 50 - 98},
+		{Src: Span(50, 48), Delta: 52 - 50},
+	}
+
+	if got := table.Map(79); got != 81 {
+		t.Errorf("Map(79) = %d, want 81", got)
+	}
+	if got := table.Map(14); got != 14 {
+		t.Errorf("Map(14) = %d, want 14", got)
+	}
+
+	seeds := NewSet(Span(79, 14), Span(55, 13), Span(96, 5))
+	got := table.MapSet(seeds).Intervals()
+	// 96, 97 -> 98, 99; 98, 99 -> 50, 51; 100 -> 100
+	wantSet := NewSet(Span(50, 2), Span(57, 13), Span(81, 14), Span(98, 3)).Intervals()
+	if !reflect.DeepEqual(got, wantSet) {
+		t.Errorf("MapSet() = %v, want %v", got, wantSet)
+	}
+
+	// every integer maps the same as one at a time
+	for _, i := range seeds.Intervals() {
+		for v := i.From; v < i.To; v++ {
+			if m := table.Map(v); !table.MapSet(seeds).Contains(m) {
+				t.Errorf("MapSet() misses %d, the image of %d", m, v)
+			}
+		}
+	}
+}
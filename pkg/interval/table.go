@@ -0,0 +1,48 @@
+package interval
+
+// Offset maps the integers in Src to themselves plus Delta.
+type Offset[T Integer] struct {
+	Src   Interval[T]
+	Delta T
+}
+
+// Table is a piecewise-linear mapping: integers in the source of an offset
+// are moved by its delta, all others map to themselves. When sources
+// overlap, the first offset wins.
+type Table[T Integer] []Offset[T]
+
+// Map returns the image of v.
+func (t Table[T]) Map(v T) T {
+	for _, o := range t {
+		if o.Src.Contains(v) {
+			return v + o.Delta
+		}
+	}
+	return v
+}
+
+// MapSet returns the image of every integer in s, without visiting them.
+func (t Table[T]) MapSet(s Set[T]) Set[T] {
+	var mapped []Interval[T]
+
+	rest := s
+	for _, o := range t {
+		src := NewSet(o.Src)
+		for _, i := range rest.Intersect(src).intervals {
+			mapped = append(mapped, i.Shift(o.Delta))
+		}
+		rest = rest.Difference(src)
+	}
+
+	return NewSet(append(mapped, rest.intervals...)...)
+}
+
+// Boundaries returns the ends of the sources of the offsets, where the
+// mapping changes; see Set.Split.
+func (t Table[T]) Boundaries() []T {
+	bs := make([]T, 0, 2*len(t))
+	for _, o := range t {
+		bs = append(bs, o.Src.From, o.Src.To)
+	}
+	return bs
+}